@@ -0,0 +1,388 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tompaz3/go-enumerator/internal/generator"
+)
+
+var (
+	errAliasedValueNotFound = errors.New("aliased value not found in values")
+	errCodedValueNotFound   = errors.New("coded value not found in values")
+	errInvalidCode          = errors.New("value code is not an integer")
+)
+
+// enumFlags holds the flags describing a single enum.
+type enumFlags struct {
+	copyrightFile                   *string
+	destination                     *string
+	packageName                     *string
+	typeName                        *string
+	valueNames                      *string
+	aliases                         *string
+	codes                           *string
+	naming                          *string
+	undefinedValue                  *string
+	defaultValue                    *string
+	parseIgnoreCase                 *bool
+	parseTrimSpace                  *bool
+	parseIgnoreSeparators           *bool
+	marshalJSON                     *bool
+	marshalJSONV2                   *bool
+	marshalJSONAsCode               *bool
+	unmarshalUnknownToUndefined     *bool
+	unmarshalJSONNilToDefault       *bool
+	unmarshalJSONUnknownToUndefined *bool
+	marshalText                     *bool
+	unmarshalTextToUndefined        *bool
+	unmarshalTextNilToDefault       *bool
+	unmarshalTextUnknownToUndefined *bool
+	marshalYAML                     *bool
+	unmarshalYAMLToUndefined        *bool
+	unmarshalYAMLNilToDefault       *bool
+	unmarshalYAMLUnknownToUndefined *bool
+	marshalXML                      *bool
+	unmarshalXMLToUndefined         *bool
+	unmarshalXMLNilToDefault        *bool
+	unmarshalXMLUnknownToUndefined  *bool
+	marshalBinary                   *bool
+	marshalBinaryNumeric            *bool
+	marshalGraphQL                  *bool
+	marshalSQL                      *bool
+	scanSQLNullToUndefined          *bool
+	scanSQLNullToDefault            *bool
+	scanSQLUnknownToUndefined       *bool
+	marshalSQLAsCode                *bool
+	preserveUnknown                 *bool
+	nullable                        *bool
+	set                             *bool
+	flag                            *bool
+	checkSumType                    *bool
+}
+
+func newEnumFlags() enumFlags {
+	return enumFlags{
+		copyrightFile: flag.String("copyright", "", "license file"),
+		destination:   flag.String("destination", "", "destination file"),
+		packageName:   flag.String("package", "", "package name"),
+		typeName:      flag.String("type", "", "type name"),
+		valueNames: flag.String(
+			"values",
+			"",
+			"comma-separated values, each either Identifier or Identifier=name (e.g. InProgress=in-progress)",
+		),
+		aliases: flag.String(
+			"aliases",
+			"",
+			"comma-separated Identifier=alias pairs, additional names accepted when parsing (e.g. Red=Crimson,Red=Scarlet)",
+		),
+		codes: flag.String(
+			"codes",
+			"",
+			"comma-separated Identifier=code pairs, stable numeric codes declared for all values (e.g. Red=10,Green=20)",
+		),
+		naming: flag.String(
+			"naming",
+			"",
+			"naming strategy deriving value names from identifiers: snake_case, kebab-case, SCREAMING_SNAKE or lowerCamel",
+		),
+		undefinedValue:  flag.String("undefined", "", "undefined value name - must be one of the values"),
+		defaultValue:    flag.String("default", "", "default value name - must be one of the values"),
+		parseIgnoreCase: flag.Bool("parse-ignore-case", false, "parse value names ignoring case"),
+		parseTrimSpace:  flag.Bool("parse-trim-space", false, "parse value names ignoring leading and trailing spaces"),
+		parseIgnoreSeparators: flag.Bool(
+			"parse-ignore-separators",
+			false,
+			"parse value names treating '-', '_' and ' ' as equivalent",
+		),
+		marshalJSON: flag.Bool("marshal-json", false, "generate JSON marshalling"),
+		unmarshalUnknownToUndefined: flag.Bool(
+			"unmarshal-json-to-undefined",
+			false,
+			"unmarshal unknown or null values to undefined",
+		),
+		unmarshalJSONNilToDefault: flag.Bool(
+			"unmarshal-json-nil-to-default",
+			false,
+			"unmarshal null values to default",
+		),
+		unmarshalJSONUnknownToUndefined: flag.Bool(
+			"unmarshal-json-unknown-to-undefined",
+			false,
+			"unmarshal unknown values to undefined",
+		),
+		marshalJSONV2: flag.Bool(
+			"marshal-json-v2",
+			false,
+			"generate encoding/json/v2 marshalling (built with GOEXPERIMENT=jsonv2), requires -marshal-json",
+		),
+		marshalJSONAsCode: flag.Bool(
+			"marshal-json-as-code",
+			false,
+			"marshal JSON as value code number instead of name, requires -codes",
+		),
+		marshalText: flag.Bool("marshal-text", false, "generate encoding.TextMarshaler and encoding.TextUnmarshaler"),
+		unmarshalTextToUndefined: flag.Bool(
+			"unmarshal-text-to-undefined",
+			false,
+			"unmarshal unknown or empty text to undefined",
+		),
+		unmarshalTextNilToDefault: flag.Bool(
+			"unmarshal-text-nil-to-default",
+			false,
+			"unmarshal empty text to default",
+		),
+		unmarshalTextUnknownToUndefined: flag.Bool(
+			"unmarshal-text-unknown-to-undefined",
+			false,
+			"unmarshal unknown text to undefined",
+		),
+		marshalYAML: flag.Bool("marshal-yaml", false, "generate gopkg.in/yaml.v3 yaml.Marshaler and yaml.Unmarshaler"),
+		unmarshalYAMLToUndefined: flag.Bool(
+			"unmarshal-yaml-to-undefined",
+			false,
+			"unmarshal unknown or null YAML values to undefined",
+		),
+		unmarshalYAMLNilToDefault: flag.Bool(
+			"unmarshal-yaml-nil-to-default",
+			false,
+			"unmarshal null YAML values to default",
+		),
+		unmarshalYAMLUnknownToUndefined: flag.Bool(
+			"unmarshal-yaml-unknown-to-undefined",
+			false,
+			"unmarshal unknown YAML values to undefined",
+		),
+		marshalXML: flag.Bool("marshal-xml", false, "generate XML element and attribute marshalling"),
+		unmarshalXMLToUndefined: flag.Bool(
+			"unmarshal-xml-to-undefined",
+			false,
+			"unmarshal unknown or empty XML elements and attributes to undefined",
+		),
+		unmarshalXMLNilToDefault: flag.Bool(
+			"unmarshal-xml-nil-to-default",
+			false,
+			"unmarshal empty XML elements and attributes to default",
+		),
+		unmarshalXMLUnknownToUndefined: flag.Bool(
+			"unmarshal-xml-unknown-to-undefined",
+			false,
+			"unmarshal unknown XML elements and attributes to undefined",
+		),
+		marshalBinary: flag.Bool(
+			"marshal-binary",
+			false,
+			"generate encoding.BinaryMarshaler, encoding.BinaryUnmarshaler, gob.GobEncoder and gob.GobDecoder",
+		),
+		marshalBinaryNumeric: flag.Bool(
+			"marshal-binary-numeric",
+			false,
+			"encode binary and gob as value code instead of name, requires -codes",
+		),
+		marshalGraphQL: flag.Bool(
+			"marshal-graphql",
+			false,
+			"generate gqlgen MarshalGQL and UnmarshalGQL, and GraphQL enum schema",
+		),
+		marshalSQL: flag.Bool("marshal-sql", false, "generate sql.Scanner and driver.Valuer"),
+		scanSQLNullToUndefined: flag.Bool(
+			"scan-sql-null-to-undefined",
+			false,
+			"scan SQL NULL to undefined",
+		),
+		scanSQLNullToDefault: flag.Bool(
+			"scan-sql-null-to-default",
+			false,
+			"scan SQL NULL to default",
+		),
+		scanSQLUnknownToUndefined: flag.Bool(
+			"scan-sql-unknown-to-undefined",
+			false,
+			"scan unknown SQL values to undefined",
+		),
+		marshalSQLAsCode: flag.Bool(
+			"marshal-sql-as-code",
+			false,
+			"store SQL value code instead of name, requires -codes",
+		),
+		preserveUnknown: flag.Bool(
+			"preserve-unknown",
+			false,
+			"unmarshal unknown names to values reporting IsKnown() == false and marshalled back to the original name",
+		),
+		nullable: flag.Bool(
+			"nullable",
+			false,
+			"generate NullType wrapper for optional values, with the enabled marshalling methods",
+		),
+		set: flag.Bool(
+			"set",
+			false,
+			"generate TypeSet bitset type with set algebra, with the enabled JSON, text and SQL marshalling methods",
+		),
+		flag: flag.Bool(
+			"flag",
+			false,
+			"generate TypeFlag and TypeSliceFlag implementing flag.Value and pflag.Value",
+		),
+		checkSumType: flag.Bool(
+			"go-check-sumtype",
+			false,
+			"add go-check-sumtype comment for exhaustiveness check using https://github.com/alecthomas/go-check-sumtype",
+		),
+	}
+}
+
+func (f enumFlags) enum(inputArgs string) (generator.Enum, error) {
+	values := stripValueNames(*f.valueNames)
+	if err := applyAliases(values, *f.aliases); err != nil {
+		return generator.Enum{}, err
+	}
+	if err := applyCodes(values, *f.codes); err != nil {
+		return generator.Enum{}, err
+	}
+
+	return generator.Enum{
+		InputArgs:      inputArgs,
+		CopyrightFile:  *f.copyrightFile,
+		Destination:    f.destination,
+		Package:        *f.packageName,
+		Type:           *f.typeName,
+		Values:         values,
+		Naming:         generator.NamingStrategy(*f.naming),
+		UndefinedValue: *f.undefinedValue,
+		DefaultValue:   *f.defaultValue,
+		Parsing: generator.ParseOptions{
+			IgnoreCase:       *f.parseIgnoreCase,
+			TrimSpace:        *f.parseTrimSpace,
+			IgnoreSeparators: *f.parseIgnoreSeparators,
+		},
+		Marshalling: generator.MarshalOptions{
+			JSONOptions: generator.JSONMarshalOptions{
+				Generate:           *f.marshalJSON,
+				NilToUndefined:     *f.unmarshalUnknownToUndefined,
+				NilToDefault:       *f.unmarshalJSONNilToDefault,
+				UnknownToUndefined: *f.unmarshalJSONUnknownToUndefined,
+				V2:                 *f.marshalJSONV2,
+				AsCode:             *f.marshalJSONAsCode,
+			},
+			TextOptions: generator.TextMarshalOptions{
+				Generate:           *f.marshalText,
+				NilToUndefined:     *f.unmarshalTextToUndefined,
+				NilToDefault:       *f.unmarshalTextNilToDefault,
+				UnknownToUndefined: *f.unmarshalTextUnknownToUndefined,
+			},
+			YAMLOptions: generator.YAMLMarshalOptions{
+				Generate:           *f.marshalYAML,
+				NilToUndefined:     *f.unmarshalYAMLToUndefined,
+				NilToDefault:       *f.unmarshalYAMLNilToDefault,
+				UnknownToUndefined: *f.unmarshalYAMLUnknownToUndefined,
+			},
+			XMLOptions: generator.XMLMarshalOptions{
+				Generate:           *f.marshalXML,
+				NilToUndefined:     *f.unmarshalXMLToUndefined,
+				NilToDefault:       *f.unmarshalXMLNilToDefault,
+				UnknownToUndefined: *f.unmarshalXMLUnknownToUndefined,
+			},
+			BinaryOptions: generator.BinaryMarshalOptions{
+				Generate: *f.marshalBinary,
+				Numeric:  *f.marshalBinaryNumeric,
+			},
+			GraphQLOptions: generator.GraphQLMarshalOptions{
+				Generate: *f.marshalGraphQL,
+			},
+			SQLOptions: generator.SQLMarshalOptions{
+				Generate:           *f.marshalSQL,
+				NullToUndefined:    *f.scanSQLNullToUndefined,
+				NullToDefault:      *f.scanSQLNullToDefault,
+				UnknownToUndefined: *f.scanSQLUnknownToUndefined,
+				AsCode:             *f.marshalSQLAsCode,
+			},
+		},
+		PreserveUnknown: *f.preserveUnknown,
+		Nullable:        *f.nullable,
+		Set:             *f.set,
+		Flag:            *f.flag,
+		CheckSumType:    *f.checkSumType,
+	}, nil
+}
+
+func stripValueNames(valueNames string) []generator.Value {
+	if valueNames == "" {
+		return []generator.Value{}
+	}
+	definitions := strings.Split(valueNames, ",")
+	values := make([]generator.Value, 0, len(definitions))
+	for _, definition := range definitions {
+		values = append(values, generator.NewValue(definition))
+	}
+	return values
+}
+
+func applyAliases(values []generator.Value, aliases string) error {
+	if aliases == "" {
+		return nil
+	}
+
+	for _, definition := range strings.Split(aliases, ",") {
+		identifier, alias, _ := strings.Cut(definition, "=")
+		index := slices.IndexFunc(values, func(value generator.Value) bool {
+			return value.Identifier == identifier
+		})
+		if index < 0 {
+			return fmt.Errorf("%w: %q", errAliasedValueNotFound, identifier)
+		}
+		values[index].Aliases = append(values[index].Aliases, alias)
+	}
+	return nil
+}
+
+func applyCodes(values []generator.Value, codes string) error {
+	if codes == "" {
+		return nil
+	}
+
+	for _, definition := range strings.Split(codes, ",") {
+		identifier, codeDefinition, _ := strings.Cut(definition, "=")
+		index := slices.IndexFunc(values, func(value generator.Value) bool {
+			return value.Identifier == identifier
+		})
+		if index < 0 {
+			return fmt.Errorf("%w: %q", errCodedValueNotFound, identifier)
+		}
+		code, err := strconv.Atoi(codeDefinition)
+		if err != nil {
+			return fmt.Errorf("%w: %q", errInvalidCode, definition)
+		}
+		values[index].Code = &code
+	}
+	return nil
+}
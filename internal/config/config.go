@@ -0,0 +1,340 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tompaz3/go-enumerator/internal/generator"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported config file format, expected .yaml, .yml or .json")
+
+// File is the root of the declarative enums configuration.
+type File struct {
+	Enums []Enum `json:"enums" yaml:"enums"`
+}
+
+// Enum declares a single enum to be generated.
+// Relative Destination and Copyright paths are resolved against the config file directory.
+type Enum struct {
+	Destination     string            `json:"destination"     yaml:"destination"`
+	Copyright       string            `json:"copyright"       yaml:"copyright"`
+	Package         string            `json:"package"         yaml:"package"`
+	Type            string            `json:"type"            yaml:"type"`
+	Description     string            `json:"description"     yaml:"description"`
+	Values          []Value           `json:"values"          yaml:"values"`
+	Naming          string            `json:"naming"          yaml:"naming"`
+	Attributes      map[string]string `json:"attributes"      yaml:"attributes"`
+	Undefined       string            `json:"undefined"       yaml:"undefined"`
+	Default         string            `json:"default"         yaml:"default"`
+	Parsing         Parsing           `json:"parsing"         yaml:"parsing"`
+	Marshalling     Marshalling       `json:"marshalling"     yaml:"marshalling"`
+	PreserveUnknown bool              `json:"preserveUnknown" yaml:"preserve-unknown"`
+	Nullable        bool              `json:"nullable"        yaml:"nullable"`
+	Set             bool              `json:"set"             yaml:"set"`
+	Flag            bool              `json:"flag"            yaml:"flag"`
+	CheckSumType    bool              `json:"goCheckSumtype"  yaml:"go-check-sumtype"`
+}
+
+// Value is declared either as an "Identifier" or "Identifier=name" string,
+// or as a mapping with identifier, name, aliases, code, attributes, description and deprecated keys.
+type Value struct {
+	Identifier  string         `json:"identifier"  yaml:"identifier"`
+	Name        string         `json:"name"        yaml:"name"`
+	Aliases     []string       `json:"aliases"     yaml:"aliases"`
+	Code        *int           `json:"code"        yaml:"code"`
+	Attributes  map[string]any `json:"attributes"  yaml:"attributes"`
+	Description string         `json:"description" yaml:"description"`
+	Deprecated  string         `json:"deprecated"  yaml:"deprecated"`
+}
+
+func (v *Value) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		var definition string
+		if err := node.Decode(&definition); err != nil {
+			return err
+		}
+		*v = newValue(definition)
+		return nil
+	}
+
+	type plainValue Value
+	var value plainValue
+	if err := node.Decode(&value); err != nil {
+		return err
+	}
+	*v = Value(value)
+	return nil
+}
+
+func (v *Value) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var definition string
+		if err := json.Unmarshal(data, &definition); err != nil {
+			return err
+		}
+		*v = newValue(definition)
+		return nil
+	}
+
+	type plainValue Value
+	var value plainValue
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	*v = Value(value)
+	return nil
+}
+
+func newValue(definition string) Value {
+	value := generator.NewValue(definition)
+	return Value{
+		Identifier: value.Identifier,
+		Name:       value.Name,
+	}
+}
+
+type Parsing struct {
+	IgnoreCase       bool `json:"ignoreCase"       yaml:"ignore-case"`
+	TrimSpace        bool `json:"trimSpace"        yaml:"trim-space"`
+	IgnoreSeparators bool `json:"ignoreSeparators" yaml:"ignore-separators"`
+}
+
+type Marshalling struct {
+	JSON    JSONMarshalling    `json:"json"    yaml:"json"`
+	Text    TextMarshalling    `json:"text"    yaml:"text"`
+	YAML    YAMLMarshalling    `json:"yaml"    yaml:"yaml"`
+	XML     XMLMarshalling     `json:"xml"     yaml:"xml"`
+	Binary  BinaryMarshalling  `json:"binary"  yaml:"binary"`
+	GraphQL GraphQLMarshalling `json:"graphql" yaml:"graphql"`
+	SQL     SQLMarshalling     `json:"sql"     yaml:"sql"`
+}
+
+type JSONMarshalling struct {
+	Generate           bool `json:"generate"           yaml:"generate"`
+	NilToUndefined     bool `json:"nilToUndefined"     yaml:"nil-to-undefined"`
+	NilToDefault       bool `json:"nilToDefault"       yaml:"nil-to-default"`
+	UnknownToUndefined bool `json:"unknownToUndefined" yaml:"unknown-to-undefined"`
+	V2                 bool `json:"v2"                 yaml:"v2"`
+	AsCode             bool `json:"asCode"             yaml:"as-code"`
+}
+
+type TextMarshalling struct {
+	Generate           bool `json:"generate"           yaml:"generate"`
+	NilToUndefined     bool `json:"nilToUndefined"     yaml:"nil-to-undefined"`
+	NilToDefault       bool `json:"nilToDefault"       yaml:"nil-to-default"`
+	UnknownToUndefined bool `json:"unknownToUndefined" yaml:"unknown-to-undefined"`
+}
+
+type YAMLMarshalling struct {
+	Generate           bool `json:"generate"           yaml:"generate"`
+	NilToUndefined     bool `json:"nilToUndefined"     yaml:"nil-to-undefined"`
+	NilToDefault       bool `json:"nilToDefault"       yaml:"nil-to-default"`
+	UnknownToUndefined bool `json:"unknownToUndefined" yaml:"unknown-to-undefined"`
+}
+
+type XMLMarshalling struct {
+	Generate           bool `json:"generate"           yaml:"generate"`
+	NilToUndefined     bool `json:"nilToUndefined"     yaml:"nil-to-undefined"`
+	NilToDefault       bool `json:"nilToDefault"       yaml:"nil-to-default"`
+	UnknownToUndefined bool `json:"unknownToUndefined" yaml:"unknown-to-undefined"`
+}
+
+type BinaryMarshalling struct {
+	Generate bool `json:"generate" yaml:"generate"`
+	Numeric  bool `json:"numeric"  yaml:"numeric"`
+}
+
+type GraphQLMarshalling struct {
+	Generate bool `json:"generate" yaml:"generate"`
+}
+
+type SQLMarshalling struct {
+	Generate           bool `json:"generate"           yaml:"generate"`
+	NullToUndefined    bool `json:"nullToUndefined"    yaml:"null-to-undefined"`
+	NullToDefault      bool `json:"nullToDefault"      yaml:"null-to-default"`
+	UnknownToUndefined bool `json:"unknownToUndefined" yaml:"unknown-to-undefined"`
+	AsCode             bool `json:"asCode"             yaml:"as-code"`
+}
+
+// Load reads the config file (YAML or JSON, chosen by the file extension)
+// and maps every declared enum to generator.Enum.
+func Load(path string) ([]generator.Enum, error) {
+	content, readErr := os.ReadFile(path)
+	if readErr != nil {
+		return nil, newLoadError(path, readErr)
+	}
+
+	file, parseErr := parse(path, content)
+	if parseErr != nil {
+		return nil, newLoadError(path, parseErr)
+	}
+
+	baseDir := filepath.Dir(path)
+	enums := make([]generator.Enum, 0, len(file.Enums))
+	for _, enum := range file.Enums {
+		enums = append(enums, enum.toGeneratorEnum(baseDir))
+	}
+	return enums, nil
+}
+
+func parse(path string, content []byte) (File, error) {
+	var file File
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&file); err != nil {
+			return File{}, err
+		}
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&file); err != nil {
+			return File{}, err
+		}
+	default:
+		return File{}, ErrUnsupportedFormat
+	}
+	return file, nil
+}
+
+func (e Enum) toGeneratorEnum(baseDir string) generator.Enum {
+	var destination *string
+	if e.Destination != "" {
+		resolved := resolvePath(baseDir, e.Destination)
+		destination = &resolved
+	}
+
+	copyrightFile := ""
+	if e.Copyright != "" {
+		copyrightFile = resolvePath(baseDir, e.Copyright)
+	}
+
+	values := make([]generator.Value, 0, len(e.Values))
+	for _, value := range e.Values {
+		values = append(values, generator.Value{
+			Identifier:  value.Identifier,
+			Name:        value.Name,
+			Aliases:     value.Aliases,
+			Code:        value.Code,
+			Attributes:  value.Attributes,
+			Description: value.Description,
+			Deprecated:  value.Deprecated,
+		})
+	}
+
+	// attributes are ordered by name, as the mapping keys order is not preserved
+	var attributes []generator.Attribute
+	for _, name := range slices.Sorted(maps.Keys(e.Attributes)) {
+		attributes = append(attributes, generator.Attribute{
+			Name: name,
+			Type: generator.AttributeType(e.Attributes[name]),
+		})
+	}
+
+	return generator.Enum{
+		Destination:    destination,
+		CopyrightFile:  copyrightFile,
+		Package:        e.Package,
+		Type:           e.Type,
+		Description:    e.Description,
+		Values:         values,
+		Naming:         generator.NamingStrategy(e.Naming),
+		Attributes:     attributes,
+		UndefinedValue: e.Undefined,
+		DefaultValue:   e.Default,
+		Parsing: generator.ParseOptions{
+			IgnoreCase:       e.Parsing.IgnoreCase,
+			TrimSpace:        e.Parsing.TrimSpace,
+			IgnoreSeparators: e.Parsing.IgnoreSeparators,
+		},
+		Marshalling: generator.MarshalOptions{
+			JSONOptions: generator.JSONMarshalOptions{
+				Generate:           e.Marshalling.JSON.Generate,
+				NilToUndefined:     e.Marshalling.JSON.NilToUndefined,
+				NilToDefault:       e.Marshalling.JSON.NilToDefault,
+				UnknownToUndefined: e.Marshalling.JSON.UnknownToUndefined,
+				V2:                 e.Marshalling.JSON.V2,
+				AsCode:             e.Marshalling.JSON.AsCode,
+			},
+			TextOptions: generator.TextMarshalOptions{
+				Generate:           e.Marshalling.Text.Generate,
+				NilToUndefined:     e.Marshalling.Text.NilToUndefined,
+				NilToDefault:       e.Marshalling.Text.NilToDefault,
+				UnknownToUndefined: e.Marshalling.Text.UnknownToUndefined,
+			},
+			YAMLOptions: generator.YAMLMarshalOptions{
+				Generate:           e.Marshalling.YAML.Generate,
+				NilToUndefined:     e.Marshalling.YAML.NilToUndefined,
+				NilToDefault:       e.Marshalling.YAML.NilToDefault,
+				UnknownToUndefined: e.Marshalling.YAML.UnknownToUndefined,
+			},
+			XMLOptions: generator.XMLMarshalOptions{
+				Generate:           e.Marshalling.XML.Generate,
+				NilToUndefined:     e.Marshalling.XML.NilToUndefined,
+				NilToDefault:       e.Marshalling.XML.NilToDefault,
+				UnknownToUndefined: e.Marshalling.XML.UnknownToUndefined,
+			},
+			BinaryOptions: generator.BinaryMarshalOptions{
+				Generate: e.Marshalling.Binary.Generate,
+				Numeric:  e.Marshalling.Binary.Numeric,
+			},
+			GraphQLOptions: generator.GraphQLMarshalOptions{
+				Generate: e.Marshalling.GraphQL.Generate,
+			},
+			SQLOptions: generator.SQLMarshalOptions{
+				Generate:           e.Marshalling.SQL.Generate,
+				NullToUndefined:    e.Marshalling.SQL.NullToUndefined,
+				NullToDefault:      e.Marshalling.SQL.NullToDefault,
+				UnknownToUndefined: e.Marshalling.SQL.UnknownToUndefined,
+				AsCode:             e.Marshalling.SQL.AsCode,
+			},
+		},
+		PreserveUnknown: e.PreserveUnknown,
+		Nullable:        e.Nullable,
+		Set:             e.Set,
+		Flag:            e.Flag,
+		CheckSumType:    e.CheckSumType,
+	}
+}
+
+func resolvePath(baseDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
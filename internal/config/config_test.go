@@ -0,0 +1,228 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/go-enumerator/internal/config"
+	"github.com/tompaz3/go-enumerator/internal/generator"
+)
+
+func Test_Load(t *testing.T) {
+	t.Parallel()
+
+	circleCode, squareCode := 1, 2
+
+	colorDestination := filepath.Join("testdata", "color", "color.go")
+	shapeDestination := "/tmp/shape/shape.go"
+	statusDestination := "/tmp/status/status.go"
+	expected := []generator.Enum{
+		{
+			Destination:   &colorDestination,
+			CopyrightFile: "LICENSE",
+			Package:       "color",
+			Type:          "Color",
+			Values: []generator.Value{
+				{Identifier: "Undefined"},
+				{Identifier: "Red"},
+				{Identifier: "Green", Name: "green"},
+				{Identifier: "Blue", Name: "blue", Aliases: []string{"Navy", "Azure"}},
+			},
+			UndefinedValue: "Undefined",
+			DefaultValue:   "Red",
+			Parsing: generator.ParseOptions{
+				IgnoreCase:       true,
+				TrimSpace:        true,
+				IgnoreSeparators: true,
+			},
+			Marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{
+					Generate:       true,
+					NilToUndefined: true,
+					V2:             true,
+				},
+				TextOptions: generator.TextMarshalOptions{
+					Generate:           true,
+					NilToDefault:       true,
+					UnknownToUndefined: true,
+				},
+				YAMLOptions: generator.YAMLMarshalOptions{
+					Generate: true,
+				},
+				XMLOptions: generator.XMLMarshalOptions{
+					Generate: true,
+				},
+				BinaryOptions: generator.BinaryMarshalOptions{
+					Generate: true,
+					Numeric:  true,
+				},
+				GraphQLOptions: generator.GraphQLMarshalOptions{
+					Generate: true,
+				},
+				SQLOptions: generator.SQLMarshalOptions{
+					Generate:           true,
+					NullToUndefined:    true,
+					UnknownToUndefined: true,
+				},
+			},
+			Nullable:     true,
+			Flag:         true,
+			CheckSumType: true,
+		},
+		{
+			Destination: &shapeDestination,
+			Package:     "shape",
+			Type:        "Shape",
+			Description: "Shape is a geometric shape.\n\nShapes are drawn on the canvas.\n",
+			Values: []generator.Value{
+				{
+					Identifier:  "Circle",
+					Code:        &circleCode,
+					Attributes:  map[string]any{"symbol": "○", "rounded": true},
+					Description: "Circle has no corners.",
+				},
+				{
+					Identifier: "Square",
+					Code:       &squareCode,
+					Attributes: map[string]any{"symbol": "□", "rounded": false},
+					Deprecated: "Use Rectangle instead.",
+				},
+			},
+			Naming: generator.NamingKebabCase,
+			Attributes: []generator.Attribute{
+				{Name: "rounded", Type: generator.AttributeTypeBool},
+				{Name: "symbol", Type: generator.AttributeTypeString},
+			},
+			Marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{
+					Generate: true,
+					AsCode:   true,
+				},
+				SQLOptions: generator.SQLMarshalOptions{
+					Generate: true,
+					AsCode:   true,
+				},
+			},
+		},
+		{
+			Destination: &statusDestination,
+			Package:     "status",
+			Type:        "Status",
+			Values: []generator.Value{
+				{Identifier: "Active"},
+				{Identifier: "Inactive"},
+			},
+			Marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{
+					Generate: true,
+				},
+				TextOptions: generator.TextMarshalOptions{
+					Generate: true,
+				},
+			},
+			PreserveUnknown: true,
+			Set:             true,
+		},
+	}
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: `GIVEN YAML config WHEN Load THEN enums`,
+			path: "testdata/enums.yaml",
+		},
+		{
+			name: `GIVEN JSON config WHEN Load THEN enums`,
+			path: "testdata/enums.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			enums, err := config.Load(tt.path)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, expected, enums)
+		})
+	}
+}
+
+func Test_Load_Error(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		path string
+		then func(t *testing.T, err error)
+	}{
+		{
+			name: `GIVEN missing file WHEN Load THEN error`,
+			path: "testdata/missing.yaml",
+			then: func(t *testing.T, err error) {
+				t.Helper()
+				var loadError config.LoadError
+				assert.ErrorAs(t, err, &loadError)
+			},
+		},
+		{
+			name: `GIVEN unsupported extension WHEN Load THEN error`,
+			path: "testdata/enums.toml",
+			then: func(t *testing.T, err error) {
+				t.Helper()
+				assert.ErrorIs(t, err, config.ErrUnsupportedFormat)
+			},
+		},
+		{
+			name: `GIVEN unknown field WHEN Load THEN error`,
+			path: "testdata/unknown_field.yaml",
+			then: func(t *testing.T, err error) {
+				t.Helper()
+				var loadError config.LoadError
+				assert.ErrorAs(t, err, &loadError)
+				assert.ErrorContains(t, err, "colour")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			enums, err := config.Load(tt.path)
+
+			// then
+			assert.Nil(t, enums)
+			tt.then(t, err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import (
+	"go/token"
+	"math"
+	"strconv"
+	"unicode"
+)
+
+// AttributeType is the Go type of the attribute.
+type AttributeType string
+
+const (
+	AttributeTypeString AttributeType = "string"
+	AttributeTypeInt    AttributeType = "int"
+	AttributeTypeBool   AttributeType = "bool"
+	AttributeTypeFloat  AttributeType = "float"
+)
+
+// Attribute is a typed metadata declared for all the enum values, e.g. hex color code.
+// It is exposed as an enum interface method named after the attribute, e.g. Hex() string.
+type Attribute struct {
+	Name string
+	Type AttributeType
+}
+
+// reservedAttributeMethods are the generated methods and fields attributes can't be named after.
+var reservedAttributeMethods = map[string]struct{}{
+	"Name":               {},
+	"String":             {},
+	"Ordinal":            {},
+	"Code":               {},
+	"Description":        {},
+	"LogValue":           {},
+	"Format":             {},
+	"IsDeprecated":       {},
+	"IsKnown":            {},
+	"ToMarshallable":     {},
+	"ToJSONMarshallable": {},
+}
+
+// reservedAttributeFields are the generated base struct fields attribute fields can't be named after,
+// together with the sealed method depending on the enum type (see Enum.reservedAttributeField).
+var reservedAttributeFields = map[string]struct{}{
+	"name":        {},
+	"ordinal":     {},
+	"code":        {},
+	"description": {},
+	"deprecated":  {},
+}
+
+func (t AttributeType) valid() bool {
+	switch t {
+	case AttributeTypeString, AttributeTypeInt, AttributeTypeBool, AttributeTypeFloat:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t AttributeType) goType() string {
+	if t == AttributeTypeFloat {
+		return "float64"
+	}
+	return string(t)
+}
+
+// literal returns the Go literal of the attribute value, false if the value doesn't match the type.
+// Integral float64 values are accepted as int, since JSON numbers are decoded as float64.
+func (t AttributeType) literal(value any) (string, bool) {
+	switch t {
+	case AttributeTypeString:
+		if s, ok := value.(string); ok {
+			return strconv.Quote(s), true
+		}
+	case AttributeTypeBool:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b), true
+		}
+	case AttributeTypeInt:
+		switch v := value.(type) {
+		case int:
+			return strconv.Itoa(v), true
+		case float64:
+			// float64 represents integers exactly up to 2^53
+			if v == math.Trunc(v) && math.Abs(v) <= 1<<53 {
+				return strconv.Itoa(int(v)), true
+			}
+		}
+	case AttributeTypeFloat:
+		switch v := value.(type) {
+		case int:
+			return strconv.Itoa(v), true
+		case float64:
+			if !math.IsInf(v, 0) && !math.IsNaN(v) {
+				return strconv.FormatFloat(v, 'g', -1, 64), true
+			}
+		}
+	}
+	return "", false
+}
+
+// method returns the accessor method name, i.e. the attribute name with the first letter upper-cased.
+func (a Attribute) method() string {
+	runes := []rune(a.Name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// field returns the base struct field name, i.e. the attribute name with the first letter lower-cased.
+func (a Attribute) field() string {
+	runes := []rune(a.Name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func (a Attribute) validName() bool {
+	if !token.IsIdentifier(a.Name) {
+		return false
+	}
+	return unicode.IsLetter([]rune(a.Name)[0])
+}
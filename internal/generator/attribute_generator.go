@@ -0,0 +1,74 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// attributeGenerator generates the attribute accessor methods backed by the base struct fields.
+type attributeGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newAttributeGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *attributeGenerator {
+	return &attributeGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *attributeGenerator) generateAttributeDeclarations() {
+	for _, attribute := range g.enum.Attributes {
+		g.writer.Line("\t" + attribute.method() + "() " + attribute.Type.goType())
+	}
+}
+
+func (g *attributeGenerator) generateAttributeFields() {
+	for _, attribute := range g.enum.Attributes {
+		g.writer.Line("\t" + attribute.field() + " " + attribute.Type.goType())
+	}
+}
+
+// valueLiteralFields returns the attribute fields of the value struct literal.
+func (g *attributeGenerator) valueLiteralFields(value generationValue) string {
+	fields := ""
+	for _, attribute := range g.enum.Attributes {
+		literal, _ := attribute.Type.literal(value.attributes[attribute.Name])
+		fields += ", " + attribute.field() + ": " + literal
+	}
+	return fields
+}
+
+func (g *attributeGenerator) generateAttributeMethods() {
+	w := g.writer
+	e := g.enum
+	for _, attribute := range e.Attributes {
+		w.Line("// " + attribute.method() + " returns the value " + attribute.Name + " attribute.")
+		w.Line("func (b " + e.baseStruct + ") " + attribute.method() + "() " + attribute.Type.goType() + " {")
+		w.Line("\treturn b." + attribute.field())
+		w.Line("}")
+		w.LineBreak()
+	}
+}
@@ -0,0 +1,188 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import "strconv"
+
+// binaryMarshallerGenerator generates encoding.BinaryMarshaler, encoding.BinaryUnmarshaler,
+// gob.GobEncoder and gob.GobDecoder methods.
+// The enum is encoded either as its name or, in numeric mode, as an unsigned varint of its code.
+type binaryMarshallerGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newBinaryMarshallerGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *binaryMarshallerGenerator {
+	return &binaryMarshallerGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *binaryMarshallerGenerator) imports() []string {
+	if !g.enum.Marshalling.BinaryOptions.Generate {
+		return nil
+	}
+	if g.enum.Marshalling.BinaryOptions.Numeric {
+		return []string{"encoding/binary", "errors", "fmt"}
+	}
+	if g.enum.unknownNameLookup(false) != "" {
+		return nil
+	}
+	return []string{"errors"}
+}
+
+// generateBinaryCodes generates binary codes lookups, to be placed in the values var block.
+func (g *binaryMarshallerGenerator) generateBinaryCodes() {
+	if !g.enum.Marshalling.BinaryOptions.Generate || !g.enum.Marshalling.BinaryOptions.Numeric {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.LineBreak()
+	w.Line("\tbinaryCodesByValue = map[" + e.Type + "]uint64{")
+	for _, value := range e.values {
+		w.Line("\t\t" + value.identifier + ": " + strconv.Itoa(value.code) + ",")
+	}
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\tvaluesByBinaryCode = map[uint64]" + e.Type + "{")
+	for _, value := range e.values {
+		w.Line("\t\t" + strconv.Itoa(value.code) + ": " + value.identifier + ",")
+	}
+	w.Line("\t}")
+}
+
+func (g *binaryMarshallerGenerator) generateBinaryMarshalling() {
+	if !g.enum.Marshalling.BinaryOptions.Generate {
+		return
+	}
+	if g.enum.Marshalling.BinaryOptions.Numeric {
+		g.generateNumericMarshalBinary()
+		g.generateNumericUnmarshalBinary()
+	} else {
+		g.generateMarshalBinary()
+		g.generateUnmarshalBinary()
+	}
+	g.generateGob()
+}
+
+func (g *binaryMarshallerGenerator) generateMarshalBinary() {
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalBinary implements encoding.BinaryMarshaler encoding the enum name,")
+	w.Line("// nil enum is encoded as empty data.")
+	w.Line("func (m " + e.marshallableStruct + ") MarshalBinary() ([]byte, error) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn []byte{}, nil")
+	w.Line("\t}")
+	w.Line("\treturn []byte(m.en.String()), nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *binaryMarshallerGenerator) generateUnmarshalBinary() {
+	w := g.writer
+	e := g.enum
+	w.Line("// UnmarshalBinary implements encoding.BinaryUnmarshaler decoding the enum name,")
+	w.Line("// empty data is decoded as nil enum.")
+	w.Line("func (m *" + e.marshallableStruct + ") UnmarshalBinary(data []byte) error {")
+	w.Line("\tif len(data) == 0 {")
+	w.Line("\t\tm.en = nil")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	// OfOrUnknown
+	if lookup := e.unknownNameLookup(false); lookup != "" {
+		w.Line("\tm.en = " + lookup + "(string(data))")
+	} else { // or fail
+		w.Line("\tvalue, err := Of(string(data))")
+		w.Line("\tif err != nil {")
+		w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from binary\"), err)")
+		w.Line("\t}")
+		w.Line("\tm.en = value")
+	}
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *binaryMarshallerGenerator) generateNumericMarshalBinary() {
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalBinary implements encoding.BinaryMarshaler encoding the enum binary code as unsigned varint,")
+	w.Line("// nil enum is encoded as empty data.")
+	w.Line("func (m " + e.marshallableStruct + ") MarshalBinary() ([]byte, error) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn []byte{}, nil")
+	w.Line("\t}")
+	w.Line("\treturn binary.AppendUvarint(nil, binaryCodesByValue[m.en]), nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *binaryMarshallerGenerator) generateNumericUnmarshalBinary() {
+	w := g.writer
+	e := g.enum
+	w.Line("// UnmarshalBinary implements encoding.BinaryUnmarshaler decoding the enum binary code from unsigned varint,")
+	w.Line("// empty data is decoded as nil enum.")
+	w.Line("func (m *" + e.marshallableStruct + ") UnmarshalBinary(data []byte) error {")
+	w.Line("\tif len(data) == 0 {")
+	w.Line("\t\tm.en = nil")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\tcode, n := binary.Uvarint(data)")
+	w.Line("\tif n != len(data) {")
+	w.Line("\t\treturn errors.New(\"could not unmarshal " + e.Type + " from binary: malformed code\")")
+	w.Line("\t}")
+	w.Line("\tvalue, found := valuesByBinaryCode[code]")
+	w.Line("\tif !found {")
+	w.Line("\t\treturn fmt.Errorf(\"could not unmarshal " + e.Type + " from binary: %w: unknown code %d\", " +
+		e.invalidNameErrorSentinel + ", code)")
+	w.Line("\t}")
+	w.Line("\tm.en = value")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *binaryMarshallerGenerator) generateGob() {
+	w := g.writer
+	e := g.enum
+	w.Line("// GobEncode implements gob.GobEncoder using the binary encoding.")
+	w.Line("func (m " + e.marshallableStruct + ") GobEncode() ([]byte, error) {")
+	w.Line("\treturn m.MarshalBinary()")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// GobDecode implements gob.GobDecoder using the binary encoding.")
+	w.Line("func (m *" + e.marshallableStruct + ") GobDecode(data []byte) error {")
+	w.Line("\treturn m.UnmarshalBinary(data)")
+	w.Line("}")
+	w.LineBreak()
+}
@@ -0,0 +1,119 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import "strconv"
+
+// codeGenerator generates Code method and OfCode function for the values declaring stable numeric codes.
+type codeGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newCodeGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *codeGenerator {
+	return &codeGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *codeGenerator) imports() []string {
+	if !g.enum.hasCodes() {
+		return nil
+	}
+	return []string{"fmt"}
+}
+
+func (g *codeGenerator) generateCodeDeclaration() {
+	if !g.enum.hasCodes() {
+		return
+	}
+	g.writer.Line("\tCode() int")
+}
+
+func (g *codeGenerator) generateCodeField() {
+	if !g.enum.hasCodes() {
+		return
+	}
+	g.writer.Line("\tcode    int")
+}
+
+// valueLiteralField returns the code field of the value struct literal.
+func (g *codeGenerator) valueLiteralField(value generationValue) string {
+	if !g.enum.hasCodes() {
+		return ""
+	}
+	return ", code: " + strconv.Itoa(value.code)
+}
+
+func (g *codeGenerator) generateCodeMethod() {
+	if !g.enum.hasCodes() {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// Code returns the value stable numeric code.")
+	w.Line("func (b " + e.baseStruct + ") Code() int {")
+	w.Line("\treturn b.code")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// generateValuesByCode generates the code lookup, to be placed in the values var block.
+func (g *codeGenerator) generateValuesByCode() {
+	if !g.enum.hasCodes() {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.LineBreak()
+	w.Line("\tvaluesByCode = map[int]" + e.Type + "{")
+	for _, value := range e.values {
+		w.Line("\t\t" + value.identifier + ".Code(): " + value.identifier + ",")
+	}
+	w.Line("\t}")
+}
+
+func (g *codeGenerator) generateOfCode() {
+	if !g.enum.hasCodes() {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// OfCode maps the code to " + e.Type + " value,")
+	w.Line("// unknown codes are rejected with error matching " + e.invalidNameErrorSentinel + ".")
+	w.Line("func OfCode(code int) (" + e.Type + ", error) {")
+	w.Line("\tif value, ok := valuesByCode[code]; ok {")
+	w.Line("\t\treturn value, nil")
+	w.Line("\t}")
+	w.Line("\treturn nil, fmt.Errorf(\"%w: unknown code %d\", " + e.invalidNameErrorSentinel + ", code)")
+	w.Line("}")
+	w.LineBreak()
+}
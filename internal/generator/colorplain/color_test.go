@@ -24,6 +24,11 @@
 package color_test
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -114,3 +119,335 @@ func Test_Color_Values(t *testing.T) {
 
 	assert.Equal(t, []color.Color{color.Red, color.Green, color.Blue}, color.Values())
 }
+
+func Test_InvalidColorNameError(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		suggestion      string
+		suggestionFound bool
+		message         string
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		then  func(t *testing.T, r result)
+	}{
+		{
+			name:  `GIVEN "Rde" WHEN Of THEN error suggesting "Red"`,
+			value: "Rde",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.True(t, r.suggestionFound)
+				assert.Equal(t, "Red", r.suggestion)
+				assert.Equal(t, `invalid Color name: "Rde", did you mean "Red"?`, r.message)
+			},
+		},
+		{
+			name:  `GIVEN "blue" WHEN Of THEN error suggesting "Blue"`,
+			value: "blue",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.True(t, r.suggestionFound)
+				assert.Equal(t, "Blue", r.suggestion)
+			},
+		},
+		{
+			name:  `GIVEN "Purple" WHEN Of THEN error without suggestion`,
+			value: "Purple",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.False(t, r.suggestionFound)
+				assert.Empty(t, r.suggestion)
+				assert.Equal(t, `invalid Color name: "Purple"`, r.message)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			_, err := color.Of(tt.value)
+
+			// then
+			assert.ErrorIs(t, err, color.ErrInvalidColor)
+			var invalidColorNameError color.InvalidColorNameError
+			assert.True(t, errors.As(err, &invalidColorNameError))
+			assert.Equal(t, tt.value, invalidColorNameError.Name())
+			assert.Equal(t, "Color", invalidColorNameError.Type())
+			assert.Equal(t, []string{"Red", "Green", "Blue"}, invalidColorNameError.Allowed())
+			// and
+			suggestion, found := invalidColorNameError.Suggestion()
+			tt.then(t, result{
+				suggestion:      suggestion,
+				suggestionFound: found,
+				message:         err.Error(),
+			})
+		})
+	}
+}
+
+func Test_Color_Format(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   string
+		value    any
+		expected string
+	}{
+		{
+			name:     `GIVEN %v WHEN Sprintf THEN name`,
+			format:   "%v",
+			value:    color.Green,
+			expected: "Green",
+		},
+		{
+			name:     `GIVEN %s WHEN Sprintf THEN name`,
+			format:   "%s",
+			value:    color.Green,
+			expected: "Green",
+		},
+		{
+			name:     `GIVEN %q WHEN Sprintf THEN quoted name`,
+			format:   "%q",
+			value:    color.Green,
+			expected: `"Green"`,
+		},
+		{
+			name:     `GIVEN %d WHEN Sprintf THEN ordinal`,
+			format:   "%d",
+			value:    color.Green,
+			expected: "1",
+		},
+		{
+			name:     `GIVEN %+v WHEN Sprintf THEN Type.name`,
+			format:   "%+v",
+			value:    color.Green,
+			expected: "Color.Green",
+		},
+		{
+			name:     `GIVEN width WHEN Sprintf THEN padded name`,
+			format:   "%-6s|",
+			value:    color.Red,
+			expected: "Red   |",
+		},
+		{
+			name:     `GIVEN %+v of struct WHEN Sprintf THEN Type.name field`,
+			format:   "%+v",
+			value:    struct{ Color color.Color }{Color: color.Blue},
+			expected: "{Color:Color.Blue}",
+		},
+		{
+			name:     `GIVEN unsupported verb WHEN Sprintf THEN bad verb`,
+			format:   "%x",
+			value:    color.Blue,
+			expected: "%!x(Color=Blue)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			formatted := fmt.Sprintf(tt.format, tt.value)
+
+			// then
+			assert.Equal(t, tt.expected, formatted)
+		})
+	}
+}
+
+func Test_Color_LogValue(t *testing.T) {
+	t.Parallel()
+
+	// given
+	var output bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&output, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}))
+
+	// when
+	logger.Info("painted", "color", color.Red)
+
+	// then
+	assert.Equal(t, "level=INFO msg=painted color=Red\n", output.String())
+}
+
+func Test_Color_Ordinal(t *testing.T) {
+	t.Parallel()
+
+	for ordinal, value := range color.Values() {
+		t.Run(value.String(), func(t *testing.T) {
+			t.Parallel()
+			// when
+			fromOrdinal, err := color.FromOrdinal(value.Ordinal())
+
+			// then
+			assert.Equal(t, ordinal, value.Ordinal())
+			assert.NoError(t, err)
+			assert.Equal(t, value, fromOrdinal)
+		})
+	}
+}
+
+func Test_Color_FromOrdinal_OutOfRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		ordinal  int
+		expected string
+	}{
+		{
+			name:     `GIVEN negative ordinal WHEN FromOrdinal THEN error`,
+			ordinal:  -1,
+			expected: "invalid Color: ordinal -1 out of range [0, 3)",
+		},
+		{
+			name:     `GIVEN ordinal after last WHEN FromOrdinal THEN error`,
+			ordinal:  3,
+			expected: "invalid Color: ordinal 3 out of range [0, 3)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			value, err := color.FromOrdinal(tt.ordinal)
+
+			// then
+			assert.ErrorIs(t, err, color.ErrInvalidColor)
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, value)
+		})
+	}
+}
+
+func Test_Color_Compare(t *testing.T) {
+	t.Parallel()
+
+	// given
+	colors := []color.Color{color.Blue, nil, color.Red, color.Green, color.Red}
+
+	// when
+	slices.SortFunc(colors, color.Compare)
+
+	// then
+	assert.Equal(t, []color.Color{nil, color.Red, color.Red, color.Green, color.Blue}, colors)
+	assert.Zero(t, color.Compare(color.Green, color.Green))
+	assert.Negative(t, color.Compare(color.Red, color.Blue))
+	assert.Positive(t, color.Compare(color.Blue, color.Red))
+}
+
+func Test_Color_FirstLast(t *testing.T) {
+	t.Parallel()
+
+	// expect
+	assert.Equal(t, color.Red, color.First())
+	assert.Equal(t, color.Blue, color.Last())
+}
+
+func Test_Color_NextPrev(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		ok    bool
+	}
+
+	tests := []struct {
+		name     string
+		navigate func(color.Color) (color.Color, bool)
+		value    color.Color
+		expected result
+	}{
+		{
+			name:     `GIVEN Red WHEN Next THEN Green`,
+			navigate: color.Next,
+			value:    color.Red,
+			expected: result{color: color.Green, ok: true},
+		},
+		{
+			name:     `GIVEN last WHEN Next THEN none`,
+			navigate: color.Next,
+			value:    color.Blue,
+			expected: result{},
+		},
+		{
+			name:     `GIVEN nil WHEN Next THEN none`,
+			navigate: color.Next,
+			value:    nil,
+			expected: result{},
+		},
+		{
+			name:     `GIVEN Blue WHEN Prev THEN Green`,
+			navigate: color.Prev,
+			value:    color.Blue,
+			expected: result{color: color.Green, ok: true},
+		},
+		{
+			name:     `GIVEN first WHEN Prev THEN none`,
+			navigate: color.Prev,
+			value:    color.Red,
+			expected: result{},
+		},
+		{
+			name:     `GIVEN nil WHEN Prev THEN none`,
+			navigate: color.Prev,
+			value:    nil,
+			expected: result{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			value, ok := tt.navigate(tt.value)
+
+			// then
+			assert.Equal(t, tt.expected, result{color: value, ok: ok})
+		})
+	}
+}
+
+func Test_Color_All(t *testing.T) {
+	t.Parallel()
+
+	// when
+	ordinals := make([]int, 0)
+	colors := make([]color.Color, 0)
+	for ordinal, value := range color.All() {
+		ordinals = append(ordinals, ordinal)
+		colors = append(colors, value)
+	}
+
+	// then
+	assert.Equal(t, []int{0, 1, 2}, ordinals)
+	assert.Equal(t, color.Values(), colors)
+}
+
+func Test_Color_All_Break(t *testing.T) {
+	t.Parallel()
+
+	// when
+	colors := make([]color.Color, 0)
+	for _, value := range color.All() {
+		colors = append(colors, value)
+		if value == color.Green {
+			break
+		}
+	}
+
+	// then
+	assert.Equal(t, []color.Color{color.Red, color.Green}, colors)
+}
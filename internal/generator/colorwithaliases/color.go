@@ -0,0 +1,314 @@
+// MIT License
+// 
+// Copyright (c) 2024-2026 Tomasz Paździurek
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// 
+
+package color
+
+// Code generated by github.com/tompaz3/go-enumerator DO NOT EDIT.
+// generate
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+)
+
+type Color interface {
+	sealedColor()
+	String() string
+	Ordinal() int
+	ToMarshallable() MarshallableColor
+	ToJSONMarshallable() MarshallableColor
+}
+
+type baseColor struct {
+	name    string
+	ordinal int
+}
+
+func (b baseColor) sealedColor() {}
+
+func (b baseColor) String() string {
+	return b.name
+}
+
+// Ordinal returns the value position in Values, starting from 0.
+func (b baseColor) Ordinal() int {
+	return b.ordinal
+}
+
+// LogValue implements slog.LogValuer logging the enum name.
+func (b baseColor) LogValue() slog.Value {
+	return slog.StringValue(b.name)
+}
+
+// Format implements fmt.Formatter.
+// %v and %s format the name, %q the quoted name, %d the ordinal and %+v the Color.name form.
+func (b baseColor) Format(state fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && state.Flag('+'):
+		_, _ = fmt.Fprint(state, "Color."+b.name)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.name)
+	case verb == 'd':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.ordinal)
+	default:
+		_, _ = fmt.Fprintf(state, "%%!%c(Color=%s)", verb, b.name)
+	}
+}
+
+var (
+	Undefined = baseColor{name: "Undefined", ordinal: 0}
+	Red = baseColor{name: "Red", ordinal: 1}
+	Green = baseColor{name: "Green", ordinal: 2}
+	Blue = baseColor{name: "Blue", ordinal: 3}
+
+	allValuesByString = map[string]Color{
+		Undefined.String(): Undefined,
+		Red.String(): Red,
+		"Crimson": Red,
+		"Scarlet": Red,
+		Green.String(): Green,
+		Blue.String(): Blue,
+		"Navy": Blue,
+	}
+
+	valuesByOrdinal = [4]Color{
+		Undefined,
+		Red,
+		Green,
+		Blue,
+	}
+)
+
+// Values returns all possible values of Color
+// IMPORTANT: Generates a new slice every time to avoid overwriting enum values
+func Values() []Color {
+	return []Color{
+		Undefined,
+		Red,
+		Green,
+		Blue,
+	}
+}
+
+// FromOrdinal returns the value at the ordinal position,
+// ordinals out of range are rejected with error matching ErrInvalidColor.
+func FromOrdinal(ordinal int) (Color, error) {
+	if ordinal < 0 || ordinal >= len(valuesByOrdinal) {
+		return nil, fmt.Errorf("%w: ordinal %d out of range [0, %d)", ErrInvalidColor, ordinal, len(valuesByOrdinal))
+	}
+	return valuesByOrdinal[ordinal], nil
+}
+
+// Compare compares the values by ordinal, to be used with slices.SortFunc.
+// nil is ordered before all the values.
+func Compare(a, b Color) int {
+	return cmp.Compare(ordinalOf(a), ordinalOf(b))
+}
+
+func ordinalOf(value Color) int {
+	if value == nil {
+		return -1
+	}
+	return value.Ordinal()
+}
+
+// First returns the first declared value.
+func First() Color {
+	return valuesByOrdinal[0]
+}
+
+// Last returns the last declared value.
+func Last() Color {
+	return valuesByOrdinal[len(valuesByOrdinal)-1]
+}
+
+// Next returns the value declared after the value, false if the value is the last one or nil.
+func Next(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == len(valuesByOrdinal)-1 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()+1], true
+}
+
+// Prev returns the value declared before the value, false if the value is the first one or nil.
+func Prev(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == 0 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()-1], true
+}
+
+// All iterates over ordinals and values in the declaration order without allocating.
+func All() iter.Seq2[int, Color] {
+	return func(yield func(int, Color) bool) {
+		for ordinal, value := range valuesByOrdinal {
+			if !yield(ordinal, value) {
+				return
+			}
+		}
+	}
+}
+
+func Of(name string) (Color, error) {
+	if value, ok := allValuesByString[name]; ok {
+		return value, nil
+	}
+	return nil, newInvalidColorNameError(name)
+}
+
+func OfOrUndefined(name string) Color {
+	if value, ok := allValuesByString[name]; ok {
+		return value
+	}
+	return Undefined
+}
+
+type MarshallableColor struct {
+	en Color
+}
+
+func (b baseColor) ToMarshallable() MarshallableColor {
+	return MarshallableColor{en: b}
+}
+
+func (m MarshallableColor) ToEnum() Color {
+	return m.en
+}
+
+func (b MarshallableColor) MarshalJSON() ([]byte, error) {
+	if b.en == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.en.String())
+}
+
+// UnmarshalJSON accepts JSON string and null tokens only.
+// Any other token is rejected with *json.UnmarshalTypeError or *json.SyntaxError.
+func (b *MarshallableColor) UnmarshalJSON(jsonBytes []byte) error {
+	if len(jsonBytes) == 0 {
+		b.en = Undefined
+		return nil
+	}
+
+	if string(jsonBytes) == "null" {
+		b.en = Undefined
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(jsonBytes, &name); err != nil {
+		return errors.Join(errors.New("could not unmarshal Color from JSON"), err)
+	}
+
+	b.en = OfOrUndefined(name)
+	return nil
+}
+
+func (b baseColor) ToJSONMarshallable() MarshallableColor {
+	return MarshallableColor{en: b}
+}
+
+// ErrInvalidColor is matched by InvalidColorNameError using errors.Is.
+var ErrInvalidColor = errors.New("invalid Color")
+
+type InvalidColorNameError struct {
+	name string
+}
+
+func (e InvalidColorNameError) Error() string {
+	if suggestion, ok := e.Suggestion(); ok {
+		return "invalid Color name: \"" + e.name + "\", did you mean \"" + suggestion + "\"?"
+	}
+	return "invalid Color name: \"" + e.name + "\""
+}
+
+func (e InvalidColorNameError) Is(target error) bool {
+	return target == ErrInvalidColor
+}
+
+// Name returns the name which did not match any Color value.
+func (e InvalidColorNameError) Name() string {
+	return e.name
+}
+
+// Type returns the enum type name.
+func (e InvalidColorNameError) Type() string {
+	return "Color"
+}
+
+// Allowed returns names of all the Color values.
+func (e InvalidColorNameError) Allowed() []string {
+	values := Values()
+	allowed := make([]string, 0, len(values))
+	for _, value := range values {
+		allowed = append(allowed, value.String())
+	}
+	return allowed
+}
+
+// Suggestion returns the allowed name closest to the invalid name (case-insensitive edit distance),
+// if there is one close enough.
+func (e InvalidColorNameError) Suggestion() (string, bool) {
+	const maxDistance = 2
+	name := strings.ToLower(e.name)
+	suggestion, suggestionDistance := "", maxDistance+1
+	for _, allowed := range e.Allowed() {
+		distance := editDistance(name, strings.ToLower(allowed))
+		if distance < suggestionDistance && distance < utf8.RuneCountInString(allowed) {
+			suggestion, suggestionDistance = allowed, distance
+		}
+	}
+	return suggestion, suggestion != ""
+}
+
+func editDistance(source, target string) int {
+	sourceRunes, targetRunes := []rune(source), []rune(target)
+	previous := make([]int, len(targetRunes)+1)
+	current := make([]int, len(targetRunes)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(sourceRunes); i++ {
+		current[0] = i
+		for j := 1; j <= len(targetRunes); j++ {
+			cost := 1
+			if sourceRunes[i-1] == targetRunes[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(targetRunes)]
+}
+
+func newInvalidColorNameError(name string) InvalidColorNameError {
+	return InvalidColorNameError{name: name}
+}
+
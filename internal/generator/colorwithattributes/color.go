@@ -0,0 +1,280 @@
+// MIT License
+// 
+// Copyright (c) 2024-2026 Tomasz Paździurek
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// 
+
+package color
+
+// Code generated by github.com/tompaz3/go-enumerator DO NOT EDIT.
+// generate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+)
+
+type Color interface {
+	sealedColor()
+	String() string
+	Ordinal() int
+	Hex() string
+	Weight() int
+	Primary() bool
+	Luminance() float64
+}
+
+type baseColor struct {
+	name    string
+	ordinal int
+	hex string
+	weight int
+	primary bool
+	luminance float64
+}
+
+func (b baseColor) sealedColor() {}
+
+func (b baseColor) String() string {
+	return b.name
+}
+
+// Ordinal returns the value position in Values, starting from 0.
+func (b baseColor) Ordinal() int {
+	return b.ordinal
+}
+
+// Hex returns the value hex attribute.
+func (b baseColor) Hex() string {
+	return b.hex
+}
+
+// Weight returns the value weight attribute.
+func (b baseColor) Weight() int {
+	return b.weight
+}
+
+// Primary returns the value primary attribute.
+func (b baseColor) Primary() bool {
+	return b.primary
+}
+
+// Luminance returns the value luminance attribute.
+func (b baseColor) Luminance() float64 {
+	return b.luminance
+}
+
+// LogValue implements slog.LogValuer logging the enum name.
+func (b baseColor) LogValue() slog.Value {
+	return slog.StringValue(b.name)
+}
+
+// Format implements fmt.Formatter.
+// %v and %s format the name, %q the quoted name, %d the ordinal and %+v the Color.name form.
+func (b baseColor) Format(state fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && state.Flag('+'):
+		_, _ = fmt.Fprint(state, "Color."+b.name)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.name)
+	case verb == 'd':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.ordinal)
+	default:
+		_, _ = fmt.Fprintf(state, "%%!%c(Color=%s)", verb, b.name)
+	}
+}
+
+var (
+	Red = baseColor{name: "Red", ordinal: 0, hex: "#FF0000", weight: 3, primary: true, luminance: 0.2126}
+	Green = baseColor{name: "Green", ordinal: 1, hex: "#00FF00", weight: 2, primary: true, luminance: 0.7152}
+	Cyan = baseColor{name: "Cyan", ordinal: 2, hex: "#00FFFF", weight: 1, primary: false, luminance: 0.9278}
+
+	allValuesByString = map[string]Color{
+		Red.String(): Red,
+		Green.String(): Green,
+		Cyan.String(): Cyan,
+	}
+
+	valuesByOrdinal = [3]Color{
+		Red,
+		Green,
+		Cyan,
+	}
+)
+
+// Values returns all possible values of Color
+// IMPORTANT: Generates a new slice every time to avoid overwriting enum values
+func Values() []Color {
+	return []Color{
+		Red,
+		Green,
+		Cyan,
+	}
+}
+
+// FromOrdinal returns the value at the ordinal position,
+// ordinals out of range are rejected with error matching ErrInvalidColor.
+func FromOrdinal(ordinal int) (Color, error) {
+	if ordinal < 0 || ordinal >= len(valuesByOrdinal) {
+		return nil, fmt.Errorf("%w: ordinal %d out of range [0, %d)", ErrInvalidColor, ordinal, len(valuesByOrdinal))
+	}
+	return valuesByOrdinal[ordinal], nil
+}
+
+// Compare compares the values by ordinal, to be used with slices.SortFunc.
+// nil is ordered before all the values.
+func Compare(a, b Color) int {
+	return cmp.Compare(ordinalOf(a), ordinalOf(b))
+}
+
+func ordinalOf(value Color) int {
+	if value == nil {
+		return -1
+	}
+	return value.Ordinal()
+}
+
+// First returns the first declared value.
+func First() Color {
+	return valuesByOrdinal[0]
+}
+
+// Last returns the last declared value.
+func Last() Color {
+	return valuesByOrdinal[len(valuesByOrdinal)-1]
+}
+
+// Next returns the value declared after the value, false if the value is the last one or nil.
+func Next(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == len(valuesByOrdinal)-1 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()+1], true
+}
+
+// Prev returns the value declared before the value, false if the value is the first one or nil.
+func Prev(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == 0 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()-1], true
+}
+
+// All iterates over ordinals and values in the declaration order without allocating.
+func All() iter.Seq2[int, Color] {
+	return func(yield func(int, Color) bool) {
+		for ordinal, value := range valuesByOrdinal {
+			if !yield(ordinal, value) {
+				return
+			}
+		}
+	}
+}
+
+func Of(name string) (Color, error) {
+	if value, ok := allValuesByString[name]; ok {
+		return value, nil
+	}
+	return nil, newInvalidColorNameError(name)
+}
+
+// ErrInvalidColor is matched by InvalidColorNameError using errors.Is.
+var ErrInvalidColor = errors.New("invalid Color")
+
+type InvalidColorNameError struct {
+	name string
+}
+
+func (e InvalidColorNameError) Error() string {
+	if suggestion, ok := e.Suggestion(); ok {
+		return "invalid Color name: \"" + e.name + "\", did you mean \"" + suggestion + "\"?"
+	}
+	return "invalid Color name: \"" + e.name + "\""
+}
+
+func (e InvalidColorNameError) Is(target error) bool {
+	return target == ErrInvalidColor
+}
+
+// Name returns the name which did not match any Color value.
+func (e InvalidColorNameError) Name() string {
+	return e.name
+}
+
+// Type returns the enum type name.
+func (e InvalidColorNameError) Type() string {
+	return "Color"
+}
+
+// Allowed returns names of all the Color values.
+func (e InvalidColorNameError) Allowed() []string {
+	values := Values()
+	allowed := make([]string, 0, len(values))
+	for _, value := range values {
+		allowed = append(allowed, value.String())
+	}
+	return allowed
+}
+
+// Suggestion returns the allowed name closest to the invalid name (case-insensitive edit distance),
+// if there is one close enough.
+func (e InvalidColorNameError) Suggestion() (string, bool) {
+	const maxDistance = 2
+	name := strings.ToLower(e.name)
+	suggestion, suggestionDistance := "", maxDistance+1
+	for _, allowed := range e.Allowed() {
+		distance := editDistance(name, strings.ToLower(allowed))
+		if distance < suggestionDistance && distance < utf8.RuneCountInString(allowed) {
+			suggestion, suggestionDistance = allowed, distance
+		}
+	}
+	return suggestion, suggestion != ""
+}
+
+func editDistance(source, target string) int {
+	sourceRunes, targetRunes := []rune(source), []rune(target)
+	previous := make([]int, len(targetRunes)+1)
+	current := make([]int, len(targetRunes)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(sourceRunes); i++ {
+		current[0] = i
+		for j := 1; j <= len(targetRunes); j++ {
+			cost := 1
+			if sourceRunes[i-1] == targetRunes[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(targetRunes)]
+}
+
+func newInvalidColorNameError(name string) InvalidColorNameError {
+	return InvalidColorNameError{name: name}
+}
+
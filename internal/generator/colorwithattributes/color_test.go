@@ -0,0 +1,59 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithattributes"
+)
+
+func Test_Color_Attributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		color     color.Color
+		hex       string
+		weight    int
+		primary   bool
+		luminance float64
+	}{
+		{color: color.Red, hex: "#FF0000", weight: 3, primary: true, luminance: 0.2126},
+		{color: color.Green, hex: "#00FF00", weight: 2, primary: true, luminance: 0.7152},
+		{color: color.Cyan, hex: "#00FFFF", weight: 1, primary: false, luminance: 0.9278},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.color.String(), func(t *testing.T) {
+			t.Parallel()
+			// expect
+			assert.Equal(t, tt.hex, tt.color.Hex())
+			assert.Equal(t, tt.weight, tt.color.Weight())
+			assert.Equal(t, tt.primary, tt.color.Primary())
+			assert.InEpsilon(t, tt.luminance, tt.color.Luminance(), 1e-9)
+		})
+	}
+}
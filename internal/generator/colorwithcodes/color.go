@@ -0,0 +1,668 @@
+// MIT License
+// 
+// Copyright (c) 2024-2026 Tomasz Paździurek
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// 
+
+package color
+
+// Code generated by github.com/tompaz3/go-enumerator DO NOT EDIT.
+// generate
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"math/bits"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type Color interface {
+	sealedColor()
+	String() string
+	Ordinal() int
+	Code() int
+	ToMarshallable() MarshallableColor
+	ToJSONMarshallable() MarshallableColor
+}
+
+type baseColor struct {
+	name    string
+	ordinal int
+	code    int
+}
+
+func (b baseColor) sealedColor() {}
+
+func (b baseColor) String() string {
+	return b.name
+}
+
+// Ordinal returns the value position in Values, starting from 0.
+func (b baseColor) Ordinal() int {
+	return b.ordinal
+}
+
+// Code returns the value stable numeric code.
+func (b baseColor) Code() int {
+	return b.code
+}
+
+// LogValue implements slog.LogValuer logging the enum name.
+func (b baseColor) LogValue() slog.Value {
+	return slog.StringValue(b.name)
+}
+
+// Format implements fmt.Formatter.
+// %v and %s format the name, %q the quoted name, %d the ordinal and %+v the Color.name form.
+func (b baseColor) Format(state fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && state.Flag('+'):
+		_, _ = fmt.Fprint(state, "Color."+b.name)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.name)
+	case verb == 'd':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.ordinal)
+	default:
+		_, _ = fmt.Fprintf(state, "%%!%c(Color=%s)", verb, b.name)
+	}
+}
+
+var (
+	Red = baseColor{name: "Red", ordinal: 0, code: 10}
+	Green = baseColor{name: "Green", ordinal: 1, code: 20}
+	Blue = baseColor{name: "Blue", ordinal: 2, code: 5}
+
+	allValuesByString = map[string]Color{
+		Red.String(): Red,
+		Green.String(): Green,
+		Blue.String(): Blue,
+	}
+
+	valuesByOrdinal = [3]Color{
+		Red,
+		Green,
+		Blue,
+	}
+
+	valuesByCode = map[int]Color{
+		Red.Code(): Red,
+		Green.Code(): Green,
+		Blue.Code(): Blue,
+	}
+
+	binaryCodesByValue = map[Color]uint64{
+		Red: 10,
+		Green: 20,
+		Blue: 5,
+	}
+
+	valuesByBinaryCode = map[uint64]Color{
+		10: Red,
+		20: Green,
+		5: Blue,
+	}
+)
+
+// Values returns all possible values of Color
+// IMPORTANT: Generates a new slice every time to avoid overwriting enum values
+func Values() []Color {
+	return []Color{
+		Red,
+		Green,
+		Blue,
+	}
+}
+
+// FromOrdinal returns the value at the ordinal position,
+// ordinals out of range are rejected with error matching ErrInvalidColor.
+func FromOrdinal(ordinal int) (Color, error) {
+	if ordinal < 0 || ordinal >= len(valuesByOrdinal) {
+		return nil, fmt.Errorf("%w: ordinal %d out of range [0, %d)", ErrInvalidColor, ordinal, len(valuesByOrdinal))
+	}
+	return valuesByOrdinal[ordinal], nil
+}
+
+// Compare compares the values by ordinal, to be used with slices.SortFunc.
+// nil is ordered before all the values.
+func Compare(a, b Color) int {
+	return cmp.Compare(ordinalOf(a), ordinalOf(b))
+}
+
+func ordinalOf(value Color) int {
+	if value == nil {
+		return -1
+	}
+	return value.Ordinal()
+}
+
+// First returns the first declared value.
+func First() Color {
+	return valuesByOrdinal[0]
+}
+
+// Last returns the last declared value.
+func Last() Color {
+	return valuesByOrdinal[len(valuesByOrdinal)-1]
+}
+
+// Next returns the value declared after the value, false if the value is the last one or nil.
+func Next(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == len(valuesByOrdinal)-1 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()+1], true
+}
+
+// Prev returns the value declared before the value, false if the value is the first one or nil.
+func Prev(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == 0 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()-1], true
+}
+
+// All iterates over ordinals and values in the declaration order without allocating.
+func All() iter.Seq2[int, Color] {
+	return func(yield func(int, Color) bool) {
+		for ordinal, value := range valuesByOrdinal {
+			if !yield(ordinal, value) {
+				return
+			}
+		}
+	}
+}
+
+func Of(name string) (Color, error) {
+	if value, ok := allValuesByString[name]; ok {
+		return value, nil
+	}
+	return nil, newInvalidColorNameError(name)
+}
+
+// OfCode maps the code to Color value,
+// unknown codes are rejected with error matching ErrInvalidColor.
+func OfCode(code int) (Color, error) {
+	if value, ok := valuesByCode[code]; ok {
+		return value, nil
+	}
+	return nil, fmt.Errorf("%w: unknown code %d", ErrInvalidColor, code)
+}
+
+type MarshallableColor struct {
+	en Color
+}
+
+func (b baseColor) ToMarshallable() MarshallableColor {
+	return MarshallableColor{en: b}
+}
+
+func (m MarshallableColor) ToEnum() Color {
+	return m.en
+}
+
+func (b MarshallableColor) MarshalJSON() ([]byte, error) {
+	if b.en == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.en.Code())
+}
+
+// UnmarshalJSON accepts JSON integer number and null tokens only.
+// Any other token is rejected with *json.UnmarshalTypeError or *json.SyntaxError.
+func (b *MarshallableColor) UnmarshalJSON(jsonBytes []byte) error {
+	if len(jsonBytes) == 0 {
+		return nil
+	}
+
+	if string(jsonBytes) == "null" {
+		return nil
+	}
+
+	var code int
+	if err := json.Unmarshal(jsonBytes, &code); err != nil {
+		return errors.Join(errors.New("could not unmarshal Color from JSON"), err)
+	}
+
+	value, err := OfCode(code)
+	if err != nil {
+		return errors.Join(errors.New("could not unmarshal Color from JSON"), err)
+	}
+	b.en = value
+	return nil
+}
+
+func (b baseColor) ToJSONMarshallable() MarshallableColor {
+	return MarshallableColor{en: b}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler encoding the enum binary code as unsigned varint,
+// nil enum is encoded as empty data.
+func (m MarshallableColor) MarshalBinary() ([]byte, error) {
+	if m.en == nil {
+		return []byte{}, nil
+	}
+	return binary.AppendUvarint(nil, binaryCodesByValue[m.en]), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler decoding the enum binary code from unsigned varint,
+// empty data is decoded as nil enum.
+func (m *MarshallableColor) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		m.en = nil
+		return nil
+	}
+
+	code, n := binary.Uvarint(data)
+	if n != len(data) {
+		return errors.New("could not unmarshal Color from binary: malformed code")
+	}
+	value, found := valuesByBinaryCode[code]
+	if !found {
+		return fmt.Errorf("could not unmarshal Color from binary: %w: unknown code %d", ErrInvalidColor, code)
+	}
+	m.en = value
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the binary encoding.
+func (m MarshallableColor) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary encoding.
+func (m *MarshallableColor) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// Scan implements sql.Scanner, accepting int64, numeric string, numeric []byte and nil (NULL) values.
+func (m *MarshallableColor) Scan(src any) error {
+	var code int
+	switch value := src.(type) {
+	case nil:
+		m.en = nil
+		return nil
+	case int64:
+		code = int(value)
+	case string:
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return errors.Join(errors.New("could not scan Color from SQL"), err)
+		}
+		code = parsed
+	case []byte:
+		parsed, err := strconv.Atoi(string(value))
+		if err != nil {
+			return errors.Join(errors.New("could not scan Color from SQL"), err)
+		}
+		code = parsed
+	default:
+		return fmt.Errorf("could not scan Color from SQL value of type %T", src)
+	}
+
+	value, err := OfCode(code)
+	if err != nil {
+		return errors.Join(errors.New("could not scan Color from SQL"), err)
+	}
+	m.en = value
+	return nil
+}
+
+// Value implements driver.Valuer storing the value code, nil enum is stored as NULL.
+func (m MarshallableColor) Value() (driver.Value, error) {
+	if m.en == nil {
+		return nil, nil
+	}
+	return int64(m.en.Code()), nil
+}
+
+// NullColor represents Color that may be null.
+// Valid is true if Color is not null.
+// Present is true if NullColor was unmarshalled, even from null,
+// which tells a missing JSON field apart from an explicit null.
+type NullColor struct {
+	Color   Color
+	Valid   bool
+	Present bool
+}
+
+func (n NullColor) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return MarshallableColor{en: n.Color}.MarshalJSON()
+}
+
+func (n *NullColor) UnmarshalJSON(jsonBytes []byte) error {
+	if len(jsonBytes) == 0 || string(jsonBytes) == "null" {
+		n.Color, n.Valid, n.Present = nil, false, true
+		return nil
+	}
+
+	var marshallable MarshallableColor
+	if err := marshallable.UnmarshalJSON(jsonBytes); err != nil {
+		return err
+	}
+	n.Color, n.Valid, n.Present = marshallable.ToEnum(), true, true
+	return nil
+}
+
+func (n NullColor) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return MarshallableColor{en: n.Color}.MarshalBinary()
+}
+
+func (n *NullColor) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		n.Color, n.Valid, n.Present = nil, false, true
+		return nil
+	}
+
+	var marshallable MarshallableColor
+	if err := marshallable.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	n.Color, n.Valid, n.Present = marshallable.ToEnum(), true, true
+	return nil
+}
+
+func (n NullColor) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+func (n *NullColor) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// Scan implements sql.Scanner, NULL is scanned as not valid.
+func (n *NullColor) Scan(src any) error {
+	if src == nil {
+		n.Color, n.Valid, n.Present = nil, false, true
+		return nil
+	}
+
+	var marshallable MarshallableColor
+	if err := marshallable.Scan(src); err != nil {
+		return err
+	}
+	n.Color, n.Valid, n.Present = marshallable.ToEnum(), true, true
+	return nil
+}
+
+// Value implements driver.Valuer, not valid value is stored as NULL.
+func (n NullColor) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return MarshallableColor{en: n.Color}.Value()
+}
+
+// ColorSet is a set of Color values backed by a bitset indexed by the value ordinal.
+// Sets are immutable values comparable with ==, the zero value is an empty set.
+type ColorSet struct {
+	words [1]uint64
+}
+
+// EmptySet returns the set without any values.
+func EmptySet() ColorSet {
+	return ColorSet{}
+}
+
+// AllSet returns the set of all the values.
+func AllSet() ColorSet {
+	return EmptySet().Add(valuesByOrdinal[:]...)
+}
+
+// Add returns the set with the values added, nil values are ignored.
+func (s ColorSet) Add(values ...Color) ColorSet {
+	for _, value := range values {
+		if ordinal := ordinalOf(value); ordinal >= 0 {
+			s.words[ordinal/64] |= 1 << (ordinal % 64)
+		}
+	}
+	return s
+}
+
+// Remove returns the set with the values removed.
+func (s ColorSet) Remove(values ...Color) ColorSet {
+	for _, value := range values {
+		if ordinal := ordinalOf(value); ordinal >= 0 {
+			s.words[ordinal/64] &^= 1 << (ordinal % 64)
+		}
+	}
+	return s
+}
+
+// Contains reports whether the value is in the set.
+func (s ColorSet) Contains(value Color) bool {
+	ordinal := ordinalOf(value)
+	return ordinal >= 0 && s.words[ordinal/64]&(1<<(ordinal%64)) != 0
+}
+
+// Union returns the set of the values in either of the sets.
+func (s ColorSet) Union(other ColorSet) ColorSet {
+	for i := range s.words {
+		s.words[i] |= other.words[i]
+	}
+	return s
+}
+
+// Intersect returns the set of the values in both of the sets.
+func (s ColorSet) Intersect(other ColorSet) ColorSet {
+	for i := range s.words {
+		s.words[i] &= other.words[i]
+	}
+	return s
+}
+
+// Difference returns the set of the values in the set, but not in the other set.
+func (s ColorSet) Difference(other ColorSet) ColorSet {
+	for i := range s.words {
+		s.words[i] &^= other.words[i]
+	}
+	return s
+}
+
+// Len returns the number of the values in the set.
+func (s ColorSet) Len() int {
+	length := 0
+	for _, word := range s.words {
+		length += bits.OnesCount64(word)
+	}
+	return length
+}
+
+// All iterates over the set values in the declaration order.
+func (s ColorSet) All() iter.Seq[Color] {
+	return func(yield func(Color) bool) {
+		for _, value := range valuesByOrdinal {
+			if s.Contains(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the comma-separated names of the set values in the declaration order.
+func (s ColorSet) String() string {
+	elements := make([]string, 0, s.Len())
+	for value := range s.All() {
+		elements = append(elements, value.String())
+	}
+	return strings.Join(elements, ",")
+}
+
+// MarshalJSON marshals the set as JSON array of the values in the declaration order.
+func (s ColorSet) MarshalJSON() ([]byte, error) {
+	values := make([]MarshallableColor, 0, s.Len())
+	for value := range s.All() {
+		values = append(values, MarshallableColor{en: value})
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON unmarshals the set from JSON array of the values, null is unmarshalled to an empty set.
+// The values are unmarshalled the same way as MarshallableColor, nil values are ignored.
+func (s *ColorSet) UnmarshalJSON(jsonBytes []byte) error {
+	var values []MarshallableColor
+	if err := json.Unmarshal(jsonBytes, &values); err != nil {
+		return err
+	}
+
+	set := EmptySet()
+	for _, value := range values {
+		set = set.Add(value.ToEnum())
+	}
+	*s = set
+	return nil
+}
+
+// Scan implements sql.Scanner, accepting comma-separated codes as string, []byte and nil (NULL) values.
+// NULL and empty value are scanned to an empty set, the elements are scanned the same way as MarshallableColor.
+func (s *ColorSet) Scan(src any) error {
+	var text string
+	switch value := src.(type) {
+	case nil:
+		*s = EmptySet()
+		return nil
+	case string:
+		text = value
+	case []byte:
+		text = string(value)
+	default:
+		return fmt.Errorf("could not scan ColorSet from SQL value of type %T", src)
+	}
+
+	return s.unmarshalElements(text, func(marshallable *MarshallableColor, element string) error {
+		return marshallable.Scan(element)
+	})
+}
+
+// Value implements driver.Valuer, storing comma-separated codes in the declaration order.
+func (s ColorSet) Value() (driver.Value, error) {
+	elements := make([]string, 0, s.Len())
+	for value := range s.All() {
+		elements = append(elements, strconv.Itoa(value.Code()))
+	}
+	return strings.Join(elements, ","), nil
+}
+
+func (s *ColorSet) unmarshalElements(text string, unmarshal func(*MarshallableColor, string) error) error {
+	set := EmptySet()
+	if text != "" {
+		for _, element := range strings.Split(text, ",") {
+			var marshallable MarshallableColor
+			if err := unmarshal(&marshallable, element); err != nil {
+				return err
+			}
+			set = set.Add(marshallable.ToEnum())
+		}
+	}
+	*s = set
+	return nil
+}
+
+// ErrInvalidColor is matched by InvalidColorNameError using errors.Is.
+var ErrInvalidColor = errors.New("invalid Color")
+
+type InvalidColorNameError struct {
+	name string
+}
+
+func (e InvalidColorNameError) Error() string {
+	if suggestion, ok := e.Suggestion(); ok {
+		return "invalid Color name: \"" + e.name + "\", did you mean \"" + suggestion + "\"?"
+	}
+	return "invalid Color name: \"" + e.name + "\""
+}
+
+func (e InvalidColorNameError) Is(target error) bool {
+	return target == ErrInvalidColor
+}
+
+// Name returns the name which did not match any Color value.
+func (e InvalidColorNameError) Name() string {
+	return e.name
+}
+
+// Type returns the enum type name.
+func (e InvalidColorNameError) Type() string {
+	return "Color"
+}
+
+// Allowed returns names of all the Color values.
+func (e InvalidColorNameError) Allowed() []string {
+	values := Values()
+	allowed := make([]string, 0, len(values))
+	for _, value := range values {
+		allowed = append(allowed, value.String())
+	}
+	return allowed
+}
+
+// Suggestion returns the allowed name closest to the invalid name (case-insensitive edit distance),
+// if there is one close enough.
+func (e InvalidColorNameError) Suggestion() (string, bool) {
+	const maxDistance = 2
+	name := strings.ToLower(e.name)
+	suggestion, suggestionDistance := "", maxDistance+1
+	for _, allowed := range e.Allowed() {
+		distance := editDistance(name, strings.ToLower(allowed))
+		if distance < suggestionDistance && distance < utf8.RuneCountInString(allowed) {
+			suggestion, suggestionDistance = allowed, distance
+		}
+	}
+	return suggestion, suggestion != ""
+}
+
+func editDistance(source, target string) int {
+	sourceRunes, targetRunes := []rune(source), []rune(target)
+	previous := make([]int, len(targetRunes)+1)
+	current := make([]int, len(targetRunes)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(sourceRunes); i++ {
+		current[0] = i
+		for j := 1; j <= len(targetRunes); j++ {
+			cost := 1
+			if sourceRunes[i-1] == targetRunes[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(targetRunes)]
+}
+
+func newInvalidColorNameError(name string) InvalidColorNameError {
+	return InvalidColorNameError{name: name}
+}
+
@@ -0,0 +1,124 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package color_test
+
+import (
+	"encoding/json/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithcodes"
+)
+
+func Test_MarshallableColor_JSONV2(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json string
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN code WHEN Unmarshal THEN value`,
+			json: `5`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Blue, r.color)
+			},
+		},
+		{
+			name: `GIVEN null WHEN Unmarshal THEN nil`,
+			json: `null`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN unknown code WHEN Unmarshal THEN error`,
+			json: `1`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN name WHEN Unmarshal THEN error`,
+			json: `"Blue"`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var semanticErr *json.SemanticError
+				assert.ErrorAs(t, r.err, &semanticErr)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN fraction WHEN Unmarshal THEN error`,
+			json: `5.5`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var semanticErr *json.SemanticError
+				assert.ErrorAs(t, r.err, &semanticErr)
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var marshallable color.MarshallableColor
+			err := json.Unmarshal([]byte(tt.json), &marshallable)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_MarshalJSONTo(t *testing.T) {
+	t.Parallel()
+
+	// when
+	jsonBytes, err := json.Marshal(color.Red.ToJSONMarshallable())
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, `10`, string(jsonBytes))
+}
@@ -0,0 +1,459 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithcodes"
+)
+
+func Test_Color_Code(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		color    color.Color
+		expected int
+	}{
+		{color: color.Red, expected: 10},
+		{color: color.Green, expected: 20},
+		{color: color.Blue, expected: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.color.String(), func(t *testing.T) {
+			t.Parallel()
+			// when
+			value, err := color.OfCode(tt.color.Code())
+
+			// then
+			assert.Equal(t, tt.expected, tt.color.Code())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.color, value)
+		})
+	}
+}
+
+func Test_Color_OfCode_Unknown(t *testing.T) {
+	t.Parallel()
+
+	// when
+	value, err := color.OfCode(1)
+
+	// then
+	assert.ErrorIs(t, err, color.ErrInvalidColor)
+	assert.EqualError(t, err, "invalid Color: unknown code 1")
+	assert.Nil(t, value)
+}
+
+func Test_MarshallableColor_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected string
+	}{
+		{
+			name:     `GIVEN Blue WHEN MarshalJSON THEN code`,
+			color:    color.Blue.ToJSONMarshallable(),
+			expected: `5`,
+		},
+		{
+			name:     `GIVEN nil WHEN MarshalJSON THEN null`,
+			color:    color.MarshallableColor{},
+			expected: `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			jsonBytes, err := json.Marshal(tt.color)
+
+			// then
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(jsonBytes))
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json string
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN code WHEN UnmarshalJSON THEN value`,
+			json: `20`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN null WHEN UnmarshalJSON THEN nil`,
+			json: `null`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN unknown code WHEN UnmarshalJSON THEN error`,
+			json: `1`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN name WHEN UnmarshalJSON THEN error`,
+			json: `"Green"`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeErr *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeErr)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN fraction WHEN UnmarshalJSON THEN error`,
+			json: `20.5`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeErr *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeErr)
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var marshallable color.MarshallableColor
+			err := json.Unmarshal([]byte(tt.json), &marshallable)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_Value(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected driver.Value
+	}{
+		{
+			name:     `GIVEN Green WHEN Value THEN code`,
+			color:    color.Green.ToMarshallable(),
+			expected: int64(20),
+		},
+		{
+			name:     `GIVEN nil WHEN Value THEN NULL`,
+			color:    color.MarshallableColor{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			value, err := tt.color.Value()
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func Test_MarshallableColor_Scan(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		src  any
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN int64 code WHEN Scan THEN value`,
+			src:  int64(5),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Blue, r.color)
+			},
+		},
+		{
+			name: `GIVEN []byte code WHEN Scan THEN value`,
+			src:  []byte("10"),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN string code WHEN Scan THEN value`,
+			src:  "20",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN NULL WHEN Scan THEN nil`,
+			src:  nil,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN unknown code WHEN Scan THEN error`,
+			src:  int64(1),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN name WHEN Scan THEN error`,
+			src:  "Red",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorContains(t, r.err, "could not scan Color from SQL")
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN float WHEN Scan THEN error`,
+			src:  1.5,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.EqualError(t, r.err, "could not scan Color from SQL value of type float64")
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var marshallable color.MarshallableColor
+			err := marshallable.Scan(tt.src)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	// when
+	data, err := color.Green.ToMarshallable().MarshalBinary()
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{20}, data)
+	// and
+	var marshallable color.MarshallableColor
+	assert.NoError(t, marshallable.UnmarshalBinary(data))
+	assert.Equal(t, color.Green, marshallable.ToEnum())
+}
+
+func Test_ColorSet_Codes(t *testing.T) {
+	t.Parallel()
+
+	// given
+	set := color.EmptySet().Add(color.Blue, color.Red)
+
+	t.Run(`GIVEN set WHEN Marshal and Unmarshal JSON THEN array of codes in declaration order`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		output, err := json.Marshal(set)
+
+		// then
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[10,5]`, string(output))
+
+		// when
+		var unmarshalled color.ColorSet
+		err = json.Unmarshal(output, &unmarshalled)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, set, unmarshalled)
+	})
+
+	t.Run(`GIVEN set WHEN Value and Scan THEN comma-separated codes in declaration order`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		value, err := set.Value()
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "10,5", value)
+
+		// when
+		var scanned color.ColorSet
+		err = scanned.Scan(value)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, set, scanned)
+	})
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Strict(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN Green AND empty JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN Green AND null JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte(`null`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\u0032\u0030" JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`"\u0032\u0030"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN boolean JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`true`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			marshallable := color.Green.ToJSONMarshallable()
+
+			// when
+			err := marshallable.UnmarshalJSON(tt.json)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
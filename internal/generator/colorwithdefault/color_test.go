@@ -0,0 +1,232 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithdefault"
+)
+
+func Test_Default(t *testing.T) {
+	t.Parallel()
+
+	// expect
+	assert.Equal(t, color.Standard, color.Default())
+}
+
+func Test_MarshallableColor_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	codecs := []struct {
+		name      string
+		nil       string
+		unmarshal func(input string, marshallable *color.MarshallableColor) error
+	}{
+		{
+			name: "JSON",
+			nil:  "null",
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return json.Unmarshal([]byte(input), marshallable)
+			},
+		},
+		{
+			name: "text",
+			nil:  "",
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return marshallable.UnmarshalText([]byte(input))
+			},
+		},
+		{
+			name: "YAML",
+			nil:  "null",
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				// yaml.Unmarshal skips the unmarshaler for null, so the node is unmarshalled directly
+				var node yaml.Node
+				if err := yaml.Unmarshal([]byte(input), &node); err != nil {
+					return err
+				}
+				return marshallable.UnmarshalYAML(node.Content[0])
+			},
+		},
+		{
+			name: "XML",
+			nil:  "<color></color>",
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return xml.Unmarshal([]byte(input), marshallable)
+			},
+		},
+		{
+			name: "SQL",
+			nil:  "NULL",
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				if input == "NULL" {
+					return marshallable.Scan(nil)
+				}
+				return marshallable.Scan(input)
+			},
+		},
+	}
+	encode := map[string]func(name string) string{
+		"JSON": func(name string) string { return `"` + name + `"` },
+		"text": func(name string) string { return name },
+		"YAML": func(name string) string { return name },
+		"XML":  func(name string) string { return "<color>" + name + "</color>" },
+		"SQL":  func(name string) string { return name },
+	}
+
+	for _, codec := range codecs {
+		tests := []struct {
+			name     string
+			input    string
+			expected color.Color
+		}{
+			{
+				name:     `GIVEN nil WHEN Unmarshal THEN Default`,
+				input:    codec.nil,
+				expected: color.Standard,
+			},
+			{
+				name:     `GIVEN unknown name WHEN Unmarshal THEN Undefined`,
+				input:    encode[codec.name]("Purple"),
+				expected: color.Unknown,
+			},
+			{
+				name:     `GIVEN Red WHEN Unmarshal THEN Red`,
+				input:    encode[codec.name]("Red"),
+				expected: color.Red,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(codec.name+" "+tt.name, func(t *testing.T) {
+				t.Parallel()
+				// given
+				var marshallable color.MarshallableColor
+
+				// when
+				err := codec.unmarshal(tt.input, &marshallable)
+
+				// then
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, marshallable.ToEnum())
+			})
+		}
+	}
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Strict(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN Green AND empty JSON WHEN UnmarshalJSON THEN Standard`,
+			json: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Standard, r.color)
+			},
+		},
+		{
+			name: `GIVEN Green AND null JSON WHEN UnmarshalJSON THEN Standard`,
+			json: []byte(`null`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Standard, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\u0052ed" JSON WHEN UnmarshalJSON THEN Red`,
+			json: []byte(`"\u0052ed"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN number JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`1`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			marshallable := color.Green.ToJSONMarshallable()
+
+			// when
+			err := marshallable.UnmarshalJSON(tt.json)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
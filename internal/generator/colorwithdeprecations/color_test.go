@@ -0,0 +1,130 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithdeprecations"
+)
+
+func Test_Color_IsDeprecated(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "Red", expected: true},
+		{name: "Green", expected: false},
+		{name: "Blue", expected: true},
+		{name: "Scarlet", expected: false},
+		{name: "Navy", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			// deprecated values are looked up by name, as Of keeps accepting them
+			value, err := color.Of(tt.name)
+			assert.NoError(t, err)
+
+			// when
+			deprecated := value.IsDeprecated()
+
+			// then
+			assert.Equal(t, tt.expected, deprecated)
+		})
+	}
+}
+
+func Test_ActiveValues(t *testing.T) {
+	t.Parallel()
+
+	// when
+	values := color.ActiveValues()
+
+	// then
+	assert.Equal(t, []color.Color{color.Green, color.Scarlet, color.Navy}, values)
+	assert.Len(t, color.Values(), 5)
+}
+
+func Test_OfStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		then func(t *testing.T, value color.Color, err error)
+	}{
+		{
+			name: "Green",
+			then: func(t *testing.T, value color.Color, err error) {
+				t.Helper()
+				assert.NoError(t, err)
+				assert.Equal(t, color.Green, value)
+			},
+		},
+		{
+			name: "Red",
+			then: func(t *testing.T, value color.Color, err error) {
+				t.Helper()
+				assert.Nil(t, value)
+				assert.ErrorIs(t, err, color.ErrInvalidColor)
+				assert.EqualError(t, err, `invalid Color: "Red" is deprecated`)
+			},
+		},
+		{
+			name: "Crimson",
+			then: func(t *testing.T, value color.Color, err error) {
+				t.Helper()
+				assert.Nil(t, value)
+				assert.ErrorIs(t, err, color.ErrInvalidColor)
+				assert.EqualError(t, err, `invalid Color: "Red" is deprecated`)
+			},
+		},
+		{
+			name: "Purple",
+			then: func(t *testing.T, value color.Color, err error) {
+				t.Helper()
+				assert.Nil(t, value)
+				var invalidNameError color.InvalidColorNameError
+				assert.ErrorAs(t, err, &invalidNameError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			value, err := color.OfStrict(tt.name)
+
+			// then
+			tt.then(t, value, err)
+		})
+	}
+}
@@ -0,0 +1,366 @@
+// MIT License
+// 
+// Copyright (c) 2024-2026 Tomasz Paździurek
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// 
+
+package color
+
+// Code generated by github.com/tompaz3/go-enumerator DO NOT EDIT.
+// generate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+)
+
+type Color interface {
+	sealedColor()
+	String() string
+	Ordinal() int
+}
+
+type baseColor struct {
+	name    string
+	ordinal int
+}
+
+func (b baseColor) sealedColor() {}
+
+func (b baseColor) String() string {
+	return b.name
+}
+
+// Ordinal returns the value position in Values, starting from 0.
+func (b baseColor) Ordinal() int {
+	return b.ordinal
+}
+
+// LogValue implements slog.LogValuer logging the enum name.
+func (b baseColor) LogValue() slog.Value {
+	return slog.StringValue(b.name)
+}
+
+// Format implements fmt.Formatter.
+// %v and %s format the name, %q the quoted name, %d the ordinal and %+v the Color.name form.
+func (b baseColor) Format(state fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && state.Flag('+'):
+		_, _ = fmt.Fprint(state, "Color."+b.name)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.name)
+	case verb == 'd':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.ordinal)
+	default:
+		_, _ = fmt.Fprintf(state, "%%!%c(Color=%s)", verb, b.name)
+	}
+}
+
+var (
+	Red = baseColor{name: "Red", ordinal: 0}
+	Green = baseColor{name: "Green", ordinal: 1}
+	Blue = baseColor{name: "Blue", ordinal: 2}
+
+	allValuesByString = map[string]Color{
+		Red.String(): Red,
+		Green.String(): Green,
+		Blue.String(): Blue,
+	}
+
+	valuesByOrdinal = [3]Color{
+		Red,
+		Green,
+		Blue,
+	}
+)
+
+// Values returns all possible values of Color
+// IMPORTANT: Generates a new slice every time to avoid overwriting enum values
+func Values() []Color {
+	return []Color{
+		Red,
+		Green,
+		Blue,
+	}
+}
+
+// FromOrdinal returns the value at the ordinal position,
+// ordinals out of range are rejected with error matching ErrInvalidColor.
+func FromOrdinal(ordinal int) (Color, error) {
+	if ordinal < 0 || ordinal >= len(valuesByOrdinal) {
+		return nil, fmt.Errorf("%w: ordinal %d out of range [0, %d)", ErrInvalidColor, ordinal, len(valuesByOrdinal))
+	}
+	return valuesByOrdinal[ordinal], nil
+}
+
+// Compare compares the values by ordinal, to be used with slices.SortFunc.
+// nil is ordered before all the values.
+func Compare(a, b Color) int {
+	return cmp.Compare(ordinalOf(a), ordinalOf(b))
+}
+
+func ordinalOf(value Color) int {
+	if value == nil {
+		return -1
+	}
+	return value.Ordinal()
+}
+
+// First returns the first declared value.
+func First() Color {
+	return valuesByOrdinal[0]
+}
+
+// Last returns the last declared value.
+func Last() Color {
+	return valuesByOrdinal[len(valuesByOrdinal)-1]
+}
+
+// Next returns the value declared after the value, false if the value is the last one or nil.
+func Next(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == len(valuesByOrdinal)-1 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()+1], true
+}
+
+// Prev returns the value declared before the value, false if the value is the first one or nil.
+func Prev(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == 0 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()-1], true
+}
+
+// All iterates over ordinals and values in the declaration order without allocating.
+func All() iter.Seq2[int, Color] {
+	return func(yield func(int, Color) bool) {
+		for ordinal, value := range valuesByOrdinal {
+			if !yield(ordinal, value) {
+				return
+			}
+		}
+	}
+}
+
+func Of(name string) (Color, error) {
+	if value, ok := allValuesByString[name]; ok {
+		return value, nil
+	}
+	return nil, newInvalidColorNameError(name)
+}
+
+// FlagUsage appends all the possible Color values to the flag usage.
+func FlagUsage(usage string) string {
+	values := Values()
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, value.String())
+	}
+	return usage + " (one of: " + strings.Join(names, ", ") + ")"
+}
+
+// ColorFlag is a command line flag holding Color.
+// It implements flag.Value and pflag.Value.
+type ColorFlag struct {
+	Color Color
+}
+
+// NewColorFlag creates ColorFlag with the default value, which may be nil.
+func NewColorFlag(defaultValue Color) *ColorFlag {
+	return &ColorFlag{Color: defaultValue}
+}
+
+func (f *ColorFlag) String() string {
+	if f == nil || f.Color == nil {
+		return ""
+	}
+	return f.Color.String()
+}
+
+// Set sets the value, names not matching any Color value are rejected with InvalidColorNameError.
+func (f *ColorFlag) Set(name string) error {
+	value, err := Of(name)
+	if err != nil {
+		return err
+	}
+	f.Color = value
+	return nil
+}
+
+// Type returns the flag value type name displayed by pflag.
+func (f *ColorFlag) Type() string {
+	return "Color"
+}
+
+// ColorSliceFlag is a repeated command line flag collecting Color values.
+// It implements flag.Value, pflag.Value and pflag.SliceValue.
+// The first Set replaces the default values, subsequent ones append to them.
+type ColorSliceFlag struct {
+	Values  []Color
+	changed bool
+}
+
+// NewColorSliceFlag creates ColorSliceFlag with the default values.
+func NewColorSliceFlag(defaultValues ...Color) *ColorSliceFlag {
+	return &ColorSliceFlag{Values: defaultValues}
+}
+
+func (f *ColorSliceFlag) String() string {
+	if f == nil {
+		return "[]"
+	}
+	return "[" + strings.Join(f.GetSlice(), ",") + "]"
+}
+
+// Set appends the value, names not matching any Color value are rejected with InvalidColorNameError.
+func (f *ColorSliceFlag) Set(name string) error {
+	if !f.changed {
+		return f.Replace([]string{name})
+	}
+	return f.Append(name)
+}
+
+// Type returns the flag value type name displayed by pflag.
+func (f *ColorSliceFlag) Type() string {
+	return "ColorSlice"
+}
+
+func (f *ColorSliceFlag) Append(name string) error {
+	value, err := Of(name)
+	if err != nil {
+		return err
+	}
+	f.Values = append(f.Values, value)
+	f.changed = true
+	return nil
+}
+
+// Replace replaces all the values, none of them is replaced if any name is invalid.
+func (f *ColorSliceFlag) Replace(names []string) error {
+	values := make([]Color, 0, len(names))
+	for _, name := range names {
+		value, err := Of(name)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+	f.Values = values
+	f.changed = true
+	return nil
+}
+
+// GetSlice returns the value names, nil values are returned as empty names, like ColorFlag does.
+func (f *ColorSliceFlag) GetSlice() []string {
+	names := make([]string, 0, len(f.Values))
+	for _, value := range f.Values {
+		if value == nil {
+			names = append(names, "")
+			continue
+		}
+		names = append(names, value.String())
+	}
+	return names
+}
+
+// ErrInvalidColor is matched by InvalidColorNameError using errors.Is.
+var ErrInvalidColor = errors.New("invalid Color")
+
+type InvalidColorNameError struct {
+	name string
+}
+
+func (e InvalidColorNameError) Error() string {
+	if suggestion, ok := e.Suggestion(); ok {
+		return "invalid Color name: \"" + e.name + "\", did you mean \"" + suggestion + "\"?"
+	}
+	return "invalid Color name: \"" + e.name + "\""
+}
+
+func (e InvalidColorNameError) Is(target error) bool {
+	return target == ErrInvalidColor
+}
+
+// Name returns the name which did not match any Color value.
+func (e InvalidColorNameError) Name() string {
+	return e.name
+}
+
+// Type returns the enum type name.
+func (e InvalidColorNameError) Type() string {
+	return "Color"
+}
+
+// Allowed returns names of all the Color values.
+func (e InvalidColorNameError) Allowed() []string {
+	values := Values()
+	allowed := make([]string, 0, len(values))
+	for _, value := range values {
+		allowed = append(allowed, value.String())
+	}
+	return allowed
+}
+
+// Suggestion returns the allowed name closest to the invalid name (case-insensitive edit distance),
+// if there is one close enough.
+func (e InvalidColorNameError) Suggestion() (string, bool) {
+	const maxDistance = 2
+	name := strings.ToLower(e.name)
+	suggestion, suggestionDistance := "", maxDistance+1
+	for _, allowed := range e.Allowed() {
+		distance := editDistance(name, strings.ToLower(allowed))
+		if distance < suggestionDistance && distance < utf8.RuneCountInString(allowed) {
+			suggestion, suggestionDistance = allowed, distance
+		}
+	}
+	return suggestion, suggestion != ""
+}
+
+func editDistance(source, target string) int {
+	sourceRunes, targetRunes := []rune(source), []rune(target)
+	previous := make([]int, len(targetRunes)+1)
+	current := make([]int, len(targetRunes)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(sourceRunes); i++ {
+		current[0] = i
+		for j := 1; j <= len(targetRunes); j++ {
+			cost := 1
+			if sourceRunes[i-1] == targetRunes[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(targetRunes)]
+}
+
+func newInvalidColorNameError(name string) InvalidColorNameError {
+	return InvalidColorNameError{name: name}
+}
+
@@ -0,0 +1,283 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithflag"
+)
+
+func newFlagSet() *flag.FlagSet {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.SetOutput(&bytes.Buffer{})
+	return flagSet
+}
+
+func Test_ColorFlag_Parse(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name         string
+		defaultValue color.Color
+		args         []string
+		then         func(t *testing.T, r result)
+	}{
+		{
+			name:         `GIVEN flag WHEN Parse THEN flag value`,
+			defaultValue: color.Red,
+			args:         []string{"-color", "Blue"},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Blue, r.color)
+			},
+		},
+		{
+			name:         `GIVEN no flag WHEN Parse THEN default value`,
+			defaultValue: color.Red,
+			args:         []string{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name:         `GIVEN no flag and nil default WHEN Parse THEN nil`,
+			defaultValue: nil,
+			args:         []string{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name:         `GIVEN invalid name WHEN Parse THEN error`,
+			defaultValue: color.Red,
+			args:         []string{"-color", "Bleu"},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.EqualError(t, r.err,
+					`invalid value "Bleu" for flag -color: invalid Color name: "Bleu", did you mean "Blue"?`)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			flagSet := newFlagSet()
+			colorFlag := color.NewColorFlag(tt.defaultValue)
+			flagSet.Var(colorFlag, "color", color.FlagUsage("background color"))
+
+			// when
+			err := flagSet.Parse(tt.args)
+
+			// then
+			tt.then(t, result{
+				color: colorFlag.Color,
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_ColorFlag_Set_InvalidName(t *testing.T) {
+	t.Parallel()
+
+	// given
+	colorFlag := color.NewColorFlag(color.Red)
+
+	// when
+	err := colorFlag.Set("Bleu")
+
+	// then
+	var invalidNameErr color.InvalidColorNameError
+	assert.ErrorAs(t, err, &invalidNameErr)
+	assert.Equal(t, "Bleu", invalidNameErr.Name())
+	assert.Equal(t, color.Red, colorFlag.Color)
+}
+
+func Test_ColorFlag_Defaults(t *testing.T) {
+	t.Parallel()
+
+	// given
+	var output bytes.Buffer
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.SetOutput(&output)
+	flagSet.Var(color.NewColorFlag(color.Red), "color", color.FlagUsage("background color"))
+
+	// when
+	flagSet.PrintDefaults()
+
+	// then
+	assert.Contains(t, output.String(), "background color (one of: Red, Green, Blue) (default Red)")
+}
+
+func Test_ColorFlag_Type(t *testing.T) {
+	t.Parallel()
+
+	// expect
+	assert.Equal(t, "Color", color.NewColorFlag(nil).Type())
+	assert.Equal(t, "ColorSlice", color.NewColorSliceFlag().Type())
+}
+
+func Test_ColorSliceFlag_Parse(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		flag *color.ColorSliceFlag
+		err  error
+	}
+
+	tests := []struct {
+		name          string
+		defaultValues []color.Color
+		args          []string
+		then          func(t *testing.T, r result)
+	}{
+		{
+			name:          `GIVEN repeated flag WHEN Parse THEN flag values replace default values`,
+			defaultValues: []color.Color{color.Red},
+			args:          []string{"-color", "Blue", "-color", "Green", "-color", "Blue"},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, []color.Color{color.Blue, color.Green, color.Blue}, r.flag.Values)
+				assert.Equal(t, "[Blue,Green,Blue]", r.flag.String())
+			},
+		},
+		{
+			name:          `GIVEN no flag WHEN Parse THEN default values`,
+			defaultValues: []color.Color{color.Red, color.Green},
+			args:          []string{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, []color.Color{color.Red, color.Green}, r.flag.Values)
+				assert.Equal(t, []string{"Red", "Green"}, r.flag.GetSlice())
+			},
+		},
+		{
+			name:          `GIVEN invalid name WHEN Parse THEN error`,
+			defaultValues: nil,
+			args:          []string{"-color", "Blue", "-color", "Bleu"},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.EqualError(t, r.err,
+					`invalid value "Bleu" for flag -color: invalid Color name: "Bleu", did you mean "Blue"?`)
+				assert.Equal(t, []color.Color{color.Blue}, r.flag.Values)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			flagSet := newFlagSet()
+			colorsFlag := color.NewColorSliceFlag(tt.defaultValues...)
+			flagSet.Var(colorsFlag, "color", color.FlagUsage("background colors"))
+
+			// when
+			err := flagSet.Parse(tt.args)
+
+			// then
+			tt.then(t, result{
+				flag: colorsFlag,
+				err:  err,
+			})
+		})
+	}
+}
+
+func Test_ColorSliceFlag_Replace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		names    []string
+		expected []color.Color
+		err      error
+	}{
+		{
+			name:     `GIVEN valid names WHEN Replace THEN values replaced`,
+			names:    []string{"Green", "Blue"},
+			expected: []color.Color{color.Green, color.Blue},
+		},
+		{
+			name:     `GIVEN invalid name WHEN Replace THEN values unchanged`,
+			names:    []string{"Green", "Bleu"},
+			expected: []color.Color{color.Red},
+			err:      color.ErrInvalidColor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			colorsFlag := color.NewColorSliceFlag(color.Red)
+
+			// when
+			err := colorsFlag.Replace(tt.names)
+
+			// then
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, colorsFlag.Values)
+		})
+	}
+}
+
+func Test_ColorSliceFlag_NilValue(t *testing.T) {
+	t.Parallel()
+
+	// given
+	colorsFlag := color.NewColorSliceFlag(color.Red, nil, color.Blue)
+
+	// when
+	names := colorsFlag.GetSlice()
+	text := colorsFlag.String()
+
+	// then
+	assert.Equal(t, []string{"Red", "", "Blue"}, names)
+	assert.Equal(t, "[Red,,Blue]", text)
+}
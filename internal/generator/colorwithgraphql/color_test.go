@@ -0,0 +1,161 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithgraphql"
+)
+
+func Test_MarshallableColor_MarshalGQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected string
+	}{
+		{
+			name:     `GIVEN Red WHEN MarshalGQL THEN "RED"`,
+			color:    color.Red.ToMarshallable(),
+			expected: `"RED"`,
+		},
+		{
+			name:     `GIVEN LightBlue WHEN MarshalGQL THEN "LIGHT_BLUE"`,
+			color:    color.LightBlue.ToMarshallable(),
+			expected: `"LIGHT_BLUE"`,
+		},
+		{
+			name:     `GIVEN nil WHEN MarshalGQL THEN null`,
+			color:    color.MarshallableColor{},
+			expected: `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var buf bytes.Buffer
+			tt.color.MarshalGQL(&buf)
+
+			// then
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalGQL(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name  string
+		value any
+		then  func(t *testing.T, r result)
+	}{
+		{
+			name:  `GIVEN "LIGHT_BLUE" WHEN UnmarshalGQL THEN LightBlue`,
+			value: "LIGHT_BLUE",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.LightBlue, r.color)
+			},
+		},
+		{
+			name:  `GIVEN nil WHEN UnmarshalGQL THEN nil`,
+			value: nil,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name:  `GIVEN wire name "light-blue" WHEN UnmarshalGQL THEN error`,
+			value: "light-blue",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name:  `GIVEN number WHEN UnmarshalGQL THEN error`,
+			value: 1,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.EqualError(t, r.err, "could not unmarshal Color from GraphQL value of type int")
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var marshallable color.MarshallableColor
+			err := marshallable.UnmarshalGQL(tt.value)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_GQL_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range color.Values() {
+		t.Run(value.String(), func(t *testing.T) {
+			t.Parallel()
+			// given
+			var buf bytes.Buffer
+			value.ToMarshallable().MarshalGQL(&buf)
+			name := strings.Trim(buf.String(), `"`)
+
+			// when
+			var marshallable color.MarshallableColor
+			err := marshallable.UnmarshalGQL(name)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, value, marshallable.ToEnum())
+		})
+	}
+}
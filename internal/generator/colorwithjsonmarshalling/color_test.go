@@ -24,6 +24,7 @@
 package color_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -250,6 +251,55 @@ func Test_MarshallableColor_UnmarshalJSON(t *testing.T) {
 				assert.Zero(t, r.color)
 			},
 		},
+		{
+			name: `GIVEN escaped "\u0052ed" JSON WHEN UnmarshalJSON THEN Red`,
+			json: []byte(`"\u0052ed"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Zero(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN number JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`1`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Zero(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN boolean JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`true`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Zero(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Zero(t, r.color)
+			},
+		},
 	}
 
 	for _, tt := range tests {
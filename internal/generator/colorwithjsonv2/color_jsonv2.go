@@ -0,0 +1,113 @@
+// MIT License
+// 
+// Copyright (c) 2024-2026 Tomasz Paździurek
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// 
+
+//go:build goexperiment.jsonv2 && !go1.27
+
+package color
+
+// Code generated by github.com/tompaz3/go-enumerator DO NOT EDIT.
+// generate
+
+import (
+	"bytes"
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"errors"
+)
+
+// MarshalJSONTo implements json.MarshalerTo, nil enum is marshalled to null.
+func (b MarshallableColor) MarshalJSONTo(encoder *jsontext.Encoder) error {
+	if b.en == nil {
+		return encoder.WriteToken(jsontext.Null)
+	}
+	return encoder.WriteToken(jsontext.String(b.en.String()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom accepting JSON string and null tokens only.
+// Any other token is skipped and rejected with *json.SemanticError.
+func (b *MarshallableColor) UnmarshalJSONFrom(decoder *jsontext.Decoder) error {
+	switch kind := decoder.PeekKind(); kind {
+	case jsontext.KindNull:
+		if _, err := decoder.ReadToken(); err != nil {
+			return err
+		}
+		return nil
+	case jsontext.KindString:
+	default:
+		if err := decoder.SkipValue(); err != nil {
+			return err
+		}
+		return &json.SemanticError{
+			JSONKind: kind,
+			Err:      errors.New("could not unmarshal Color from JSON"),
+		}
+	}
+
+	raw, err := decoder.ReadValue()
+	if err != nil {
+		return err
+	}
+
+	name := []byte(raw[1 : len(raw)-1])
+	if bytes.IndexByte(name, '\\') >= 0 {
+		if name, err = jsontext.AppendUnquote(nil, raw); err != nil {
+			return err
+		}
+	}
+	if value, ok := allValuesByString[string(name)]; ok {
+		b.en = value
+		return nil
+	}
+
+	value, err := Of(string(name))
+	if err != nil {
+		return errors.Join(errors.New("could not unmarshal Color from JSON"), err)
+	}
+	b.en = value
+	return nil
+}
+
+func (n NullColor) MarshalJSONTo(encoder *jsontext.Encoder) error {
+	if !n.Valid {
+		return encoder.WriteToken(jsontext.Null)
+	}
+	return MarshallableColor{en: n.Color}.MarshalJSONTo(encoder)
+}
+
+func (n *NullColor) UnmarshalJSONFrom(decoder *jsontext.Decoder) error {
+	if decoder.PeekKind() == jsontext.KindNull {
+		if _, err := decoder.ReadToken(); err != nil {
+			return err
+		}
+		n.Color, n.Valid, n.Present = nil, false, true
+		return nil
+	}
+
+	var marshallable MarshallableColor
+	if err := marshallable.UnmarshalJSONFrom(decoder); err != nil {
+		return err
+	}
+	n.Color, n.Valid, n.Present = marshallable.ToEnum(), true, true
+	return nil
+}
+
@@ -0,0 +1,222 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package color_test
+
+import (
+	"bytes"
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithjsonv2"
+)
+
+type document struct {
+	Color color.MarshallableColor `json:"color"`
+	Next  string                  `json:"next"`
+}
+
+func Test_MarshallableColor_MarshalJSONTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected string
+	}{
+		{
+			name:     `GIVEN Red WHEN Marshal THEN "Red"`,
+			color:    color.Red.ToJSONMarshallable(),
+			expected: `{"color":"Red","next":""}`,
+		},
+		{
+			name:     `GIVEN nil WHEN Marshal THEN null`,
+			color:    color.MarshallableColor{},
+			expected: `{"color":null,"next":""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			content, err := json.Marshal(document{Color: tt.color})
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalJSONFrom(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		document document
+		err      error
+	}
+
+	tests := []struct {
+		name string
+		json string
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN "Red" WHEN Unmarshal THEN Red`,
+			json: `{"color":"Red","next":"ok"}`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.document.Color.ToEnum())
+				assert.Equal(t, "ok", r.document.Next)
+			},
+		},
+		{
+			name: `GIVEN escaped "Red" WHEN Unmarshal THEN Red`,
+			json: `{"color":"\u0052ed"}`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.document.Color.ToEnum())
+			},
+		},
+		{
+			name: `GIVEN null WHEN Unmarshal THEN zero`,
+			json: `{"color":null,"next":"ok"}`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.document.Color.ToEnum())
+				assert.Equal(t, "ok", r.document.Next)
+			},
+		},
+		{
+			name: `GIVEN "InvalidColor" WHEN Unmarshal THEN error`,
+			json: `{"color":"InvalidColor"}`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Nil(t, r.document.Color.ToEnum())
+			},
+		},
+		{
+			name: `GIVEN number WHEN Unmarshal THEN semantic error`,
+			json: `{"color":1}`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var semanticError *json.SemanticError
+				assert.ErrorAs(t, r.err, &semanticError)
+				assert.Nil(t, r.document.Color.ToEnum())
+			},
+		},
+		{
+			name: `GIVEN object WHEN Unmarshal THEN semantic error`,
+			json: `{"color":{"name":"Red"}}`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var semanticError *json.SemanticError
+				assert.ErrorAs(t, r.err, &semanticError)
+				assert.Nil(t, r.document.Color.ToEnum())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var doc document
+			err := json.Unmarshal([]byte(tt.json), &doc)
+
+			// then
+			tt.then(t, result{
+				document: doc,
+				err:      err,
+			})
+		})
+	}
+}
+
+//nolint:paralleltest // allocations of parallel tests would be counted as well
+func Test_MarshallableColor_UnmarshalJSONFrom_Allocations(t *testing.T) {
+	// given
+	const runs = 100
+	// AllocsPerRun calls the function once more to warm up
+	decoder := jsontext.NewDecoder(bytes.NewBuffer(bytes.Repeat([]byte(`"Green" `), runs+1)))
+	var marshallable color.MarshallableColor
+	var err error
+
+	// when
+	allocations := testing.AllocsPerRun(runs, func() {
+		err = marshallable.UnmarshalJSONFrom(decoder)
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, allocations)
+	assert.Equal(t, color.Green, marshallable.ToEnum())
+}
+
+func Test_NullColor_JSONV2(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		json     string
+		expected color.NullColor
+	}{
+		{
+			name:     `GIVEN "Green" WHEN Unmarshal THEN valid Green`,
+			json:     `"Green"`,
+			expected: color.NullColor{Color: color.Green, Valid: true, Present: true},
+		},
+		{
+			name:     `GIVEN null WHEN Unmarshal THEN present but not valid`,
+			json:     `null`,
+			expected: color.NullColor{Present: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var nullColor color.NullColor
+			err := json.Unmarshal([]byte(tt.json), &nullColor)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, nullColor)
+			// and
+			content, err := json.Marshal(nullColor)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(content))
+		})
+	}
+}
@@ -0,0 +1,616 @@
+// MIT License
+// 
+// Copyright (c) 2024-2026 Tomasz Paździurek
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// 
+
+package color
+
+// Code generated by github.com/tompaz3/go-enumerator DO NOT EDIT.
+// generate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"math/bits"
+	"strings"
+	"unicode/utf8"
+)
+
+type Color interface {
+	sealedColor()
+	String() string
+	Ordinal() int
+}
+
+type baseColor struct {
+	name    string
+	ordinal int
+}
+
+func (b baseColor) sealedColor() {}
+
+func (b baseColor) String() string {
+	return b.name
+}
+
+// Ordinal returns the value position in Values, starting from 0.
+func (b baseColor) Ordinal() int {
+	return b.ordinal
+}
+
+// LogValue implements slog.LogValuer logging the enum name.
+func (b baseColor) LogValue() slog.Value {
+	return slog.StringValue(b.name)
+}
+
+// Format implements fmt.Formatter.
+// %v and %s format the name, %q the quoted name, %d the ordinal and %+v the Color.name form.
+func (b baseColor) Format(state fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && state.Flag('+'):
+		_, _ = fmt.Fprint(state, "Color."+b.name)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.name)
+	case verb == 'd':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.ordinal)
+	default:
+		_, _ = fmt.Fprintf(state, "%%!%c(Color=%s)", verb, b.name)
+	}
+}
+
+var (
+	Shade0 = baseColor{name: "Shade0", ordinal: 0}
+	Shade1 = baseColor{name: "Shade1", ordinal: 1}
+	Shade2 = baseColor{name: "Shade2", ordinal: 2}
+	Shade3 = baseColor{name: "Shade3", ordinal: 3}
+	Shade4 = baseColor{name: "Shade4", ordinal: 4}
+	Shade5 = baseColor{name: "Shade5", ordinal: 5}
+	Shade6 = baseColor{name: "Shade6", ordinal: 6}
+	Shade7 = baseColor{name: "Shade7", ordinal: 7}
+	Shade8 = baseColor{name: "Shade8", ordinal: 8}
+	Shade9 = baseColor{name: "Shade9", ordinal: 9}
+	Shade10 = baseColor{name: "Shade10", ordinal: 10}
+	Shade11 = baseColor{name: "Shade11", ordinal: 11}
+	Shade12 = baseColor{name: "Shade12", ordinal: 12}
+	Shade13 = baseColor{name: "Shade13", ordinal: 13}
+	Shade14 = baseColor{name: "Shade14", ordinal: 14}
+	Shade15 = baseColor{name: "Shade15", ordinal: 15}
+	Shade16 = baseColor{name: "Shade16", ordinal: 16}
+	Shade17 = baseColor{name: "Shade17", ordinal: 17}
+	Shade18 = baseColor{name: "Shade18", ordinal: 18}
+	Shade19 = baseColor{name: "Shade19", ordinal: 19}
+	Shade20 = baseColor{name: "Shade20", ordinal: 20}
+	Shade21 = baseColor{name: "Shade21", ordinal: 21}
+	Shade22 = baseColor{name: "Shade22", ordinal: 22}
+	Shade23 = baseColor{name: "Shade23", ordinal: 23}
+	Shade24 = baseColor{name: "Shade24", ordinal: 24}
+	Shade25 = baseColor{name: "Shade25", ordinal: 25}
+	Shade26 = baseColor{name: "Shade26", ordinal: 26}
+	Shade27 = baseColor{name: "Shade27", ordinal: 27}
+	Shade28 = baseColor{name: "Shade28", ordinal: 28}
+	Shade29 = baseColor{name: "Shade29", ordinal: 29}
+	Shade30 = baseColor{name: "Shade30", ordinal: 30}
+	Shade31 = baseColor{name: "Shade31", ordinal: 31}
+	Shade32 = baseColor{name: "Shade32", ordinal: 32}
+	Shade33 = baseColor{name: "Shade33", ordinal: 33}
+	Shade34 = baseColor{name: "Shade34", ordinal: 34}
+	Shade35 = baseColor{name: "Shade35", ordinal: 35}
+	Shade36 = baseColor{name: "Shade36", ordinal: 36}
+	Shade37 = baseColor{name: "Shade37", ordinal: 37}
+	Shade38 = baseColor{name: "Shade38", ordinal: 38}
+	Shade39 = baseColor{name: "Shade39", ordinal: 39}
+	Shade40 = baseColor{name: "Shade40", ordinal: 40}
+	Shade41 = baseColor{name: "Shade41", ordinal: 41}
+	Shade42 = baseColor{name: "Shade42", ordinal: 42}
+	Shade43 = baseColor{name: "Shade43", ordinal: 43}
+	Shade44 = baseColor{name: "Shade44", ordinal: 44}
+	Shade45 = baseColor{name: "Shade45", ordinal: 45}
+	Shade46 = baseColor{name: "Shade46", ordinal: 46}
+	Shade47 = baseColor{name: "Shade47", ordinal: 47}
+	Shade48 = baseColor{name: "Shade48", ordinal: 48}
+	Shade49 = baseColor{name: "Shade49", ordinal: 49}
+	Shade50 = baseColor{name: "Shade50", ordinal: 50}
+	Shade51 = baseColor{name: "Shade51", ordinal: 51}
+	Shade52 = baseColor{name: "Shade52", ordinal: 52}
+	Shade53 = baseColor{name: "Shade53", ordinal: 53}
+	Shade54 = baseColor{name: "Shade54", ordinal: 54}
+	Shade55 = baseColor{name: "Shade55", ordinal: 55}
+	Shade56 = baseColor{name: "Shade56", ordinal: 56}
+	Shade57 = baseColor{name: "Shade57", ordinal: 57}
+	Shade58 = baseColor{name: "Shade58", ordinal: 58}
+	Shade59 = baseColor{name: "Shade59", ordinal: 59}
+	Shade60 = baseColor{name: "Shade60", ordinal: 60}
+	Shade61 = baseColor{name: "Shade61", ordinal: 61}
+	Shade62 = baseColor{name: "Shade62", ordinal: 62}
+	Shade63 = baseColor{name: "Shade63", ordinal: 63}
+	Shade64 = baseColor{name: "Shade64", ordinal: 64}
+	Shade65 = baseColor{name: "Shade65", ordinal: 65}
+	Shade66 = baseColor{name: "Shade66", ordinal: 66}
+	Shade67 = baseColor{name: "Shade67", ordinal: 67}
+	Shade68 = baseColor{name: "Shade68", ordinal: 68}
+	Shade69 = baseColor{name: "Shade69", ordinal: 69}
+
+	allValuesByString = map[string]Color{
+		Shade0.String(): Shade0,
+		Shade1.String(): Shade1,
+		Shade2.String(): Shade2,
+		Shade3.String(): Shade3,
+		Shade4.String(): Shade4,
+		Shade5.String(): Shade5,
+		Shade6.String(): Shade6,
+		Shade7.String(): Shade7,
+		Shade8.String(): Shade8,
+		Shade9.String(): Shade9,
+		Shade10.String(): Shade10,
+		Shade11.String(): Shade11,
+		Shade12.String(): Shade12,
+		Shade13.String(): Shade13,
+		Shade14.String(): Shade14,
+		Shade15.String(): Shade15,
+		Shade16.String(): Shade16,
+		Shade17.String(): Shade17,
+		Shade18.String(): Shade18,
+		Shade19.String(): Shade19,
+		Shade20.String(): Shade20,
+		Shade21.String(): Shade21,
+		Shade22.String(): Shade22,
+		Shade23.String(): Shade23,
+		Shade24.String(): Shade24,
+		Shade25.String(): Shade25,
+		Shade26.String(): Shade26,
+		Shade27.String(): Shade27,
+		Shade28.String(): Shade28,
+		Shade29.String(): Shade29,
+		Shade30.String(): Shade30,
+		Shade31.String(): Shade31,
+		Shade32.String(): Shade32,
+		Shade33.String(): Shade33,
+		Shade34.String(): Shade34,
+		Shade35.String(): Shade35,
+		Shade36.String(): Shade36,
+		Shade37.String(): Shade37,
+		Shade38.String(): Shade38,
+		Shade39.String(): Shade39,
+		Shade40.String(): Shade40,
+		Shade41.String(): Shade41,
+		Shade42.String(): Shade42,
+		Shade43.String(): Shade43,
+		Shade44.String(): Shade44,
+		Shade45.String(): Shade45,
+		Shade46.String(): Shade46,
+		Shade47.String(): Shade47,
+		Shade48.String(): Shade48,
+		Shade49.String(): Shade49,
+		Shade50.String(): Shade50,
+		Shade51.String(): Shade51,
+		Shade52.String(): Shade52,
+		Shade53.String(): Shade53,
+		Shade54.String(): Shade54,
+		Shade55.String(): Shade55,
+		Shade56.String(): Shade56,
+		Shade57.String(): Shade57,
+		Shade58.String(): Shade58,
+		Shade59.String(): Shade59,
+		Shade60.String(): Shade60,
+		Shade61.String(): Shade61,
+		Shade62.String(): Shade62,
+		Shade63.String(): Shade63,
+		Shade64.String(): Shade64,
+		Shade65.String(): Shade65,
+		Shade66.String(): Shade66,
+		Shade67.String(): Shade67,
+		Shade68.String(): Shade68,
+		Shade69.String(): Shade69,
+	}
+
+	valuesByOrdinal = [70]Color{
+		Shade0,
+		Shade1,
+		Shade2,
+		Shade3,
+		Shade4,
+		Shade5,
+		Shade6,
+		Shade7,
+		Shade8,
+		Shade9,
+		Shade10,
+		Shade11,
+		Shade12,
+		Shade13,
+		Shade14,
+		Shade15,
+		Shade16,
+		Shade17,
+		Shade18,
+		Shade19,
+		Shade20,
+		Shade21,
+		Shade22,
+		Shade23,
+		Shade24,
+		Shade25,
+		Shade26,
+		Shade27,
+		Shade28,
+		Shade29,
+		Shade30,
+		Shade31,
+		Shade32,
+		Shade33,
+		Shade34,
+		Shade35,
+		Shade36,
+		Shade37,
+		Shade38,
+		Shade39,
+		Shade40,
+		Shade41,
+		Shade42,
+		Shade43,
+		Shade44,
+		Shade45,
+		Shade46,
+		Shade47,
+		Shade48,
+		Shade49,
+		Shade50,
+		Shade51,
+		Shade52,
+		Shade53,
+		Shade54,
+		Shade55,
+		Shade56,
+		Shade57,
+		Shade58,
+		Shade59,
+		Shade60,
+		Shade61,
+		Shade62,
+		Shade63,
+		Shade64,
+		Shade65,
+		Shade66,
+		Shade67,
+		Shade68,
+		Shade69,
+	}
+)
+
+// Values returns all possible values of Color
+// IMPORTANT: Generates a new slice every time to avoid overwriting enum values
+func Values() []Color {
+	return []Color{
+		Shade0,
+		Shade1,
+		Shade2,
+		Shade3,
+		Shade4,
+		Shade5,
+		Shade6,
+		Shade7,
+		Shade8,
+		Shade9,
+		Shade10,
+		Shade11,
+		Shade12,
+		Shade13,
+		Shade14,
+		Shade15,
+		Shade16,
+		Shade17,
+		Shade18,
+		Shade19,
+		Shade20,
+		Shade21,
+		Shade22,
+		Shade23,
+		Shade24,
+		Shade25,
+		Shade26,
+		Shade27,
+		Shade28,
+		Shade29,
+		Shade30,
+		Shade31,
+		Shade32,
+		Shade33,
+		Shade34,
+		Shade35,
+		Shade36,
+		Shade37,
+		Shade38,
+		Shade39,
+		Shade40,
+		Shade41,
+		Shade42,
+		Shade43,
+		Shade44,
+		Shade45,
+		Shade46,
+		Shade47,
+		Shade48,
+		Shade49,
+		Shade50,
+		Shade51,
+		Shade52,
+		Shade53,
+		Shade54,
+		Shade55,
+		Shade56,
+		Shade57,
+		Shade58,
+		Shade59,
+		Shade60,
+		Shade61,
+		Shade62,
+		Shade63,
+		Shade64,
+		Shade65,
+		Shade66,
+		Shade67,
+		Shade68,
+		Shade69,
+	}
+}
+
+// FromOrdinal returns the value at the ordinal position,
+// ordinals out of range are rejected with error matching ErrInvalidColor.
+func FromOrdinal(ordinal int) (Color, error) {
+	if ordinal < 0 || ordinal >= len(valuesByOrdinal) {
+		return nil, fmt.Errorf("%w: ordinal %d out of range [0, %d)", ErrInvalidColor, ordinal, len(valuesByOrdinal))
+	}
+	return valuesByOrdinal[ordinal], nil
+}
+
+// Compare compares the values by ordinal, to be used with slices.SortFunc.
+// nil is ordered before all the values.
+func Compare(a, b Color) int {
+	return cmp.Compare(ordinalOf(a), ordinalOf(b))
+}
+
+func ordinalOf(value Color) int {
+	if value == nil {
+		return -1
+	}
+	return value.Ordinal()
+}
+
+// First returns the first declared value.
+func First() Color {
+	return valuesByOrdinal[0]
+}
+
+// Last returns the last declared value.
+func Last() Color {
+	return valuesByOrdinal[len(valuesByOrdinal)-1]
+}
+
+// Next returns the value declared after the value, false if the value is the last one or nil.
+func Next(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == len(valuesByOrdinal)-1 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()+1], true
+}
+
+// Prev returns the value declared before the value, false if the value is the first one or nil.
+func Prev(value Color) (Color, bool) {
+	if value == nil || value.Ordinal() == 0 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()-1], true
+}
+
+// All iterates over ordinals and values in the declaration order without allocating.
+func All() iter.Seq2[int, Color] {
+	return func(yield func(int, Color) bool) {
+		for ordinal, value := range valuesByOrdinal {
+			if !yield(ordinal, value) {
+				return
+			}
+		}
+	}
+}
+
+func Of(name string) (Color, error) {
+	if value, ok := allValuesByString[name]; ok {
+		return value, nil
+	}
+	return nil, newInvalidColorNameError(name)
+}
+
+// ColorSet is a set of Color values backed by a bitset indexed by the value ordinal.
+// Sets are immutable values comparable with ==, the zero value is an empty set.
+type ColorSet struct {
+	words [2]uint64
+}
+
+// EmptySet returns the set without any values.
+func EmptySet() ColorSet {
+	return ColorSet{}
+}
+
+// AllSet returns the set of all the values.
+func AllSet() ColorSet {
+	return EmptySet().Add(valuesByOrdinal[:]...)
+}
+
+// Add returns the set with the values added, nil values are ignored.
+func (s ColorSet) Add(values ...Color) ColorSet {
+	for _, value := range values {
+		if ordinal := ordinalOf(value); ordinal >= 0 {
+			s.words[ordinal/64] |= 1 << (ordinal % 64)
+		}
+	}
+	return s
+}
+
+// Remove returns the set with the values removed.
+func (s ColorSet) Remove(values ...Color) ColorSet {
+	for _, value := range values {
+		if ordinal := ordinalOf(value); ordinal >= 0 {
+			s.words[ordinal/64] &^= 1 << (ordinal % 64)
+		}
+	}
+	return s
+}
+
+// Contains reports whether the value is in the set.
+func (s ColorSet) Contains(value Color) bool {
+	ordinal := ordinalOf(value)
+	return ordinal >= 0 && s.words[ordinal/64]&(1<<(ordinal%64)) != 0
+}
+
+// Union returns the set of the values in either of the sets.
+func (s ColorSet) Union(other ColorSet) ColorSet {
+	for i := range s.words {
+		s.words[i] |= other.words[i]
+	}
+	return s
+}
+
+// Intersect returns the set of the values in both of the sets.
+func (s ColorSet) Intersect(other ColorSet) ColorSet {
+	for i := range s.words {
+		s.words[i] &= other.words[i]
+	}
+	return s
+}
+
+// Difference returns the set of the values in the set, but not in the other set.
+func (s ColorSet) Difference(other ColorSet) ColorSet {
+	for i := range s.words {
+		s.words[i] &^= other.words[i]
+	}
+	return s
+}
+
+// Len returns the number of the values in the set.
+func (s ColorSet) Len() int {
+	length := 0
+	for _, word := range s.words {
+		length += bits.OnesCount64(word)
+	}
+	return length
+}
+
+// All iterates over the set values in the declaration order.
+func (s ColorSet) All() iter.Seq[Color] {
+	return func(yield func(Color) bool) {
+		for _, value := range valuesByOrdinal {
+			if s.Contains(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the comma-separated names of the set values in the declaration order.
+func (s ColorSet) String() string {
+	elements := make([]string, 0, s.Len())
+	for value := range s.All() {
+		elements = append(elements, value.String())
+	}
+	return strings.Join(elements, ",")
+}
+
+// ErrInvalidColor is matched by InvalidColorNameError using errors.Is.
+var ErrInvalidColor = errors.New("invalid Color")
+
+type InvalidColorNameError struct {
+	name string
+}
+
+func (e InvalidColorNameError) Error() string {
+	if suggestion, ok := e.Suggestion(); ok {
+		return "invalid Color name: \"" + e.name + "\", did you mean \"" + suggestion + "\"?"
+	}
+	return "invalid Color name: \"" + e.name + "\""
+}
+
+func (e InvalidColorNameError) Is(target error) bool {
+	return target == ErrInvalidColor
+}
+
+// Name returns the name which did not match any Color value.
+func (e InvalidColorNameError) Name() string {
+	return e.name
+}
+
+// Type returns the enum type name.
+func (e InvalidColorNameError) Type() string {
+	return "Color"
+}
+
+// Allowed returns names of all the Color values.
+func (e InvalidColorNameError) Allowed() []string {
+	values := Values()
+	allowed := make([]string, 0, len(values))
+	for _, value := range values {
+		allowed = append(allowed, value.String())
+	}
+	return allowed
+}
+
+// Suggestion returns the allowed name closest to the invalid name (case-insensitive edit distance),
+// if there is one close enough.
+func (e InvalidColorNameError) Suggestion() (string, bool) {
+	const maxDistance = 2
+	name := strings.ToLower(e.name)
+	suggestion, suggestionDistance := "", maxDistance+1
+	for _, allowed := range e.Allowed() {
+		distance := editDistance(name, strings.ToLower(allowed))
+		if distance < suggestionDistance && distance < utf8.RuneCountInString(allowed) {
+			suggestion, suggestionDistance = allowed, distance
+		}
+	}
+	return suggestion, suggestion != ""
+}
+
+func editDistance(source, target string) int {
+	sourceRunes, targetRunes := []rune(source), []rune(target)
+	previous := make([]int, len(targetRunes)+1)
+	current := make([]int, len(targetRunes)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(sourceRunes); i++ {
+		current[0] = i
+		for j := 1; j <= len(targetRunes); j++ {
+			cost := 1
+			if sourceRunes[i-1] == targetRunes[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(targetRunes)]
+}
+
+func newInvalidColorNameError(name string) InvalidColorNameError {
+	return InvalidColorNameError{name: name}
+}
+
@@ -0,0 +1,107 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithlargeset"
+)
+
+func Test_ColorSet_Words(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`GIVEN ordinal 64 WHEN Add THEN only the first value of the second word is contained`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		set := color.EmptySet().Add(color.Shade64)
+
+		// then
+		assert.True(t, set.Contains(color.Shade64))
+		assert.False(t, set.Contains(color.Shade0))
+		assert.False(t, set.Contains(color.Shade63))
+		assert.False(t, set.Contains(color.Shade65))
+		assert.Equal(t, 1, set.Len())
+	})
+
+	t.Run(`GIVEN AllSet WHEN Len and All THEN all the values in declaration order`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		values := slices.Collect(color.AllSet().All())
+
+		// then
+		assert.Equal(t, 70, color.AllSet().Len())
+		assert.Equal(t, color.Values(), values)
+	})
+
+	t.Run(`GIVEN values from both words added in any order WHEN All THEN values in declaration order`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		set := color.EmptySet().Add(color.Shade69, color.Shade1, color.Shade64, color.Shade63)
+
+		// when
+		values := slices.Collect(set.All())
+
+		// then
+		assert.Equal(t, []color.Color{color.Shade1, color.Shade63, color.Shade64, color.Shade69}, values)
+		assert.Equal(t, 4, set.Len())
+	})
+
+	t.Run(`GIVEN values from both words WHEN Remove THEN removed from both words`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		set := color.EmptySet().Add(color.Shade0, color.Shade63, color.Shade64, color.Shade69)
+
+		// when
+		removed := set.Remove(color.Shade63, color.Shade64)
+
+		// then
+		assert.Equal(t, []color.Color{color.Shade0, color.Shade69}, slices.Collect(removed.All()))
+		assert.Equal(t, 2, removed.Len())
+	})
+
+	t.Run(`GIVEN sets spanning both words WHEN Union, Intersect and Difference THEN set algebra across words`,
+		func(t *testing.T) {
+			t.Parallel()
+			// given
+			first := color.EmptySet().Add(color.Shade1, color.Shade64, color.Shade65)
+			second := color.EmptySet().Add(color.Shade1, color.Shade65, color.Shade69)
+
+			// when
+			union := first.Union(second)
+			intersection := first.Intersect(second)
+			difference := first.Difference(second)
+
+			// then
+			assert.Equal(t,
+				[]color.Color{color.Shade1, color.Shade64, color.Shade65, color.Shade69},
+				slices.Collect(union.All()),
+			)
+			assert.Equal(t, []color.Color{color.Shade1, color.Shade65}, slices.Collect(intersection.All()))
+			assert.Equal(t, []color.Color{color.Shade64}, slices.Collect(difference.All()))
+		})
+}
@@ -0,0 +1,247 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithlenientparsing"
+)
+
+func Test_Color_Of(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected color.Color
+	}{
+		{
+			name:     `GIVEN "Red" WHEN Of THEN Red`,
+			value:    "Red",
+			expected: color.Red,
+		},
+		{
+			name:     `GIVEN "RED" WHEN Of THEN Red`,
+			value:    "RED",
+			expected: color.Red,
+		},
+		{
+			name:     `GIVEN " red\t" WHEN Of THEN Red`,
+			value:    " red\t",
+			expected: color.Red,
+		},
+		{
+			name:     `GIVEN "dark_green" WHEN Of THEN DarkGreen`,
+			value:    "dark_green",
+			expected: color.DarkGreen,
+		},
+		{
+			name:     `GIVEN "DARK-GREEN" WHEN Of THEN DarkGreen`,
+			value:    "DARK-GREEN",
+			expected: color.DarkGreen,
+		},
+		{
+			name:     `GIVEN "Light Blue" WHEN Of THEN LightBlue`,
+			value:    "Light Blue",
+			expected: color.LightBlue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clr, err := color.Of(tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, clr)
+		})
+	}
+}
+
+func Test_Color_Of_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{
+			name:  `GIVEN "LightBlue" WHEN Of THEN error`,
+			value: "LightBlue",
+		},
+		{
+			name:  `GIVEN "dark__green" WHEN Of THEN error`,
+			value: "dark__green",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clr, err := color.Of(tt.value)
+			var invalidColorNameError color.InvalidColorNameError
+			assert.ErrorAs(t, err, &invalidColorNameError)
+			assert.Nil(t, clr)
+		})
+	}
+}
+
+func Test_Color_OfOrUndefined(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected color.Color
+	}{
+		{
+			name:     `GIVEN "light_blue " WHEN OfOrUndefined THEN LightBlue`,
+			value:    "light_blue ",
+			expected: color.LightBlue,
+		},
+		{
+			name:     `GIVEN "purple" WHEN OfOrUndefined THEN Undefined`,
+			value:    "purple",
+			expected: color.Undefined,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, color.OfOrUndefined(tt.value))
+		})
+	}
+}
+
+func Test_MarshallableColor_JSON(t *testing.T) {
+	t.Parallel()
+
+	// given
+	var marshallable color.MarshallableColor
+
+	// when
+	unmarshalErr := marshallable.UnmarshalJSON([]byte(`"DARK green"`))
+	marshalled, marshalErr := marshallable.MarshalJSON()
+
+	// then
+	assert.NoError(t, unmarshalErr)
+	assert.Equal(t, color.DarkGreen, marshallable.ToEnum())
+	// and canonical name is marshalled
+	assert.NoError(t, marshalErr)
+	assert.JSONEq(t, `"Dark Green"`, string(marshalled))
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Strict(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN Red AND empty JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN Red AND null JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte(`null`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\u0072ED" JSON WHEN UnmarshalJSON THEN Red`,
+			json: []byte(`"\u0072ED"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN number JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`1`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			marshallable := color.Red.ToJSONMarshallable()
+
+			// when
+			err := marshallable.UnmarshalJSON(tt.json)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithnames"
+)
+
+func Test_Color_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.Color
+		expected string
+	}{
+		{
+			name:     `GIVEN Red WHEN String THEN "red"`,
+			color:    color.Red,
+			expected: "red",
+		},
+		{
+			name:     `GIVEN DarkGreen WHEN String THEN "dark-green"`,
+			color:    color.DarkGreen,
+			expected: "dark-green",
+		},
+		{
+			name:     `GIVEN LightBlue WHEN String THEN "Light Blue"`,
+			color:    color.LightBlue,
+			expected: "Light Blue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.color.String())
+		})
+	}
+}
+
+func Test_Color_Of(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		then  func(t *testing.T, r result)
+	}{
+		{
+			name:  `GIVEN "dark-green" WHEN Of THEN DarkGreen`,
+			value: "dark-green",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.DarkGreen, r.color)
+			},
+		},
+		{
+			name:  `GIVEN "Light Blue" WHEN Of THEN LightBlue`,
+			value: "Light Blue",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.LightBlue, r.color)
+			},
+		},
+		{
+			name:  `GIVEN "青" multi-byte name WHEN Of THEN Azure`,
+			value: "青",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Azure, r.color)
+			},
+		},
+		{
+			name:  `GIVEN "x" WHEN Of THEN error without multi-byte name suggestion`,
+			value: "x",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var invalidColorNameError color.InvalidColorNameError
+				assert.ErrorAs(t, r.err, &invalidColorNameError)
+				_, ok := invalidColorNameError.Suggestion()
+				assert.False(t, ok)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name:  `GIVEN "DarkGreen" identifier WHEN Of THEN error`,
+			value: "DarkGreen",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var invalidColorNameError color.InvalidColorNameError
+				assert.ErrorAs(t, r.err, &invalidColorNameError)
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clr, err := color.Of(tt.value)
+			tt.then(t, result{
+				color: clr,
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_JSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		color color.Color
+		json  string
+	}{
+		{
+			name:  `GIVEN DarkGreen WHEN JSON round trip THEN "dark-green"`,
+			color: color.DarkGreen,
+			json:  `"dark-green"`,
+		},
+		{
+			name:  `GIVEN LightBlue WHEN JSON round trip THEN "Light Blue"`,
+			color: color.LightBlue,
+			json:  `"Light Blue"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			marshalled, marshalErr := tt.color.ToJSONMarshallable().MarshalJSON()
+			var unmarshalled color.MarshallableColor
+			unmarshalErr := unmarshalled.UnmarshalJSON(marshalled)
+
+			// then
+			assert.NoError(t, marshalErr)
+			assert.JSONEq(t, tt.json, string(marshalled))
+			assert.NoError(t, unmarshalErr)
+			assert.Equal(t, tt.color, unmarshalled.ToEnum())
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Strict(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN DarkGreen AND empty JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.DarkGreen, r.color)
+			},
+		},
+		{
+			name: `GIVEN DarkGreen AND null JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte(`null`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.DarkGreen, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\u0072ed" JSON WHEN UnmarshalJSON THEN Red`,
+			json: []byte(`"\u0072ed"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Equal(t, color.DarkGreen, r.color)
+			},
+		},
+		{
+			name: `GIVEN number JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`1`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.DarkGreen, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.DarkGreen, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			marshallable := color.DarkGreen.ToJSONMarshallable()
+
+			// when
+			err := marshallable.UnmarshalJSON(tt.json)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
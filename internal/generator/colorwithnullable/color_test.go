@@ -0,0 +1,420 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithnullable"
+)
+
+type patch struct {
+	Color color.NullColor `json:"color"`
+}
+
+func Test_NullColor_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		json     string
+		expected color.NullColor
+	}{
+		{
+			name:     `GIVEN "Red" WHEN UnmarshalJSON THEN valid Red`,
+			json:     `{"color": "Red"}`,
+			expected: color.NullColor{Color: color.Red, Valid: true, Present: true},
+		},
+		{
+			name:     `GIVEN null WHEN UnmarshalJSON THEN present but not valid`,
+			json:     `{"color": null}`,
+			expected: color.NullColor{Present: true},
+		},
+		{
+			name:     `GIVEN missing field WHEN UnmarshalJSON THEN not present`,
+			json:     `{}`,
+			expected: color.NullColor{},
+		},
+		{
+			name:     `GIVEN unknown value WHEN UnmarshalJSON THEN valid Undefined`,
+			json:     `{"color": "Purple"}`,
+			expected: color.NullColor{Color: color.Undefined, Valid: true, Present: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var unmarshalled patch
+			err := json.Unmarshal([]byte(tt.json), &unmarshalled)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, unmarshalled.Color)
+		})
+	}
+}
+
+func Test_NullColor_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.NullColor
+		expected string
+	}{
+		{
+			name:     `GIVEN valid Green WHEN MarshalJSON THEN "Green"`,
+			color:    color.NullColor{Color: color.Green, Valid: true},
+			expected: `{"color": "Green"}`,
+		},
+		{
+			name:     `GIVEN not valid WHEN MarshalJSON THEN null`,
+			color:    color.NullColor{Color: color.Green},
+			expected: `{"color": null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			marshalled, err := json.Marshal(patch{Color: tt.color})
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(marshalled))
+		})
+	}
+}
+
+func Test_NullColor_Text(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.NullColor
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		text string
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN "Blue" WHEN UnmarshalText THEN valid Blue`,
+			text: "Blue",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.NullColor{Color: color.Blue, Valid: true, Present: true}, r.color)
+			},
+		},
+		{
+			name: `GIVEN empty text WHEN UnmarshalText THEN present but not valid`,
+			text: "",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.NullColor{Present: true}, r.color)
+			},
+		},
+		{
+			name: `GIVEN "Purple" WHEN UnmarshalText THEN error`,
+			text: "Purple",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Equal(t, color.NullColor{}, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var nullColor color.NullColor
+			err := nullColor.UnmarshalText([]byte(tt.text))
+			tt.then(t, result{
+				color: nullColor,
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_NullColor_SQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		src      any
+		expected color.NullColor
+	}{
+		{
+			name:     `GIVEN "Red" WHEN Scan and Value THEN valid Red`,
+			src:      "Red",
+			expected: color.NullColor{Color: color.Red, Valid: true, Present: true},
+		},
+		{
+			name:     `GIVEN NULL WHEN Scan and Value THEN not valid`,
+			src:      nil,
+			expected: color.NullColor{Present: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var nullColor color.NullColor
+			scanErr := nullColor.Scan(tt.src)
+			value, valueErr := nullColor.Value()
+
+			// then
+			assert.NoError(t, scanErr)
+			assert.Equal(t, tt.expected, nullColor)
+			assert.NoError(t, valueErr)
+			assert.Equal(t, driver.Value(tt.src), value)
+		})
+	}
+}
+
+func Test_NullColor_YAML(t *testing.T) {
+	t.Parallel()
+
+	type document struct {
+		Color color.NullColor `yaml:"color"`
+	}
+
+	tests := []struct {
+		name     string
+		yaml     string
+		expected color.NullColor
+	}{
+		{
+			name:     `GIVEN "Green" WHEN Unmarshal and Marshal THEN valid Green`,
+			yaml:     "color: Green\n",
+			expected: color.NullColor{Color: color.Green, Valid: true, Present: true},
+		},
+		{
+			name:     `GIVEN null WHEN Unmarshal and Marshal THEN not valid`,
+			yaml:     "color: null\n",
+			expected: color.NullColor{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var doc document
+			unmarshalErr := yaml.Unmarshal([]byte(tt.yaml), &doc)
+			content, marshalErr := yaml.Marshal(doc)
+
+			// then
+			assert.NoError(t, unmarshalErr)
+			assert.Equal(t, tt.expected, doc.Color)
+			assert.NoError(t, marshalErr)
+			assert.Equal(t, tt.yaml, string(content))
+		})
+	}
+}
+
+func Test_NullColor_XML(t *testing.T) {
+	t.Parallel()
+
+	type document struct {
+		XMLName xml.Name        `xml:"document"`
+		Tone    color.NullColor `xml:"tone,attr"`
+		Color   color.NullColor `xml:"color"`
+	}
+
+	tests := []struct {
+		name     string
+		xml      string
+		expected document
+	}{
+		{
+			name: `GIVEN Red element and Blue attribute WHEN Unmarshal and Marshal THEN valid`,
+			xml:  `<document tone="Blue"><color>Red</color></document>`,
+			expected: document{
+				Tone:  color.NullColor{Color: color.Blue, Valid: true, Present: true},
+				Color: color.NullColor{Color: color.Red, Valid: true, Present: true},
+			},
+		},
+		{
+			name:     `GIVEN missing element and attribute WHEN Unmarshal and Marshal THEN not valid`,
+			xml:      `<document></document>`,
+			expected: document{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var doc document
+			unmarshalErr := xml.Unmarshal([]byte(tt.xml), &doc)
+			content, marshalErr := xml.Marshal(doc)
+
+			// then
+			assert.NoError(t, unmarshalErr)
+			assert.Equal(t, tt.expected.Tone, doc.Tone)
+			assert.Equal(t, tt.expected.Color, doc.Color)
+			assert.NoError(t, marshalErr)
+			assert.Equal(t, tt.xml, string(content))
+		})
+	}
+}
+
+func Test_NullColor_UnmarshalXML_Empty(t *testing.T) {
+	t.Parallel()
+
+	// given
+	nullColor := color.NullColor{Color: color.Red, Valid: true}
+
+	// when
+	err := xml.Unmarshal([]byte(`<color></color>`), &nullColor)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, color.NullColor{Present: true}, nullColor)
+}
+
+func Test_NullColor_Gob(t *testing.T) {
+	t.Parallel()
+
+	type document struct {
+		Color  color.NullColor
+		Accent color.NullColor
+	}
+
+	// given
+	expected := document{Color: color.NullColor{Color: color.Green, Valid: true, Present: true}}
+
+	// when
+	var buf bytes.Buffer
+	encodeErr := gob.NewEncoder(&buf).Encode(expected)
+	var actual document
+	decodeErr := gob.NewDecoder(&buf).Decode(&actual)
+
+	// then
+	assert.NoError(t, encodeErr)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, expected, actual)
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Strict(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN Green AND empty JSON WHEN UnmarshalJSON THEN Undefined`,
+			json: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.color)
+			},
+		},
+		{
+			name: `GIVEN Green AND null JSON WHEN UnmarshalJSON THEN Undefined`,
+			json: []byte(`null`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\u0052ed" JSON WHEN UnmarshalJSON THEN Red`,
+			json: []byte(`"\u0052ed"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN number JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`1`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			marshallable := color.Green.ToJSONMarshallable()
+
+			// when
+			err := marshallable.UnmarshalJSON(tt.json)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithnumericbinarymarshalling"
+)
+
+func Test_MarshallableColor_Binary_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range color.Values() {
+		t.Run(value.String(), func(t *testing.T) {
+			t.Parallel()
+			// when
+			data, marshalErr := value.ToMarshallable().MarshalBinary()
+			var marshallable color.MarshallableColor
+			unmarshalErr := marshallable.UnmarshalBinary(data)
+
+			// then
+			assert.NoError(t, marshalErr)
+			assert.NoError(t, unmarshalErr)
+			assert.Equal(t, value, marshallable.ToEnum())
+		})
+	}
+}
+
+func Test_MarshallableColor_Gob_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type palette struct {
+		Colors []color.MarshallableColor
+		Accent color.MarshallableColor
+	}
+
+	// given
+	expected := palette{Accent: color.Blue.ToMarshallable()}
+	for _, value := range color.Values() {
+		expected.Colors = append(expected.Colors, value.ToMarshallable())
+	}
+
+	// when
+	var buf bytes.Buffer
+	encodeErr := gob.NewEncoder(&buf).Encode(expected)
+	var actual palette
+	decodeErr := gob.NewDecoder(&buf).Decode(&actual)
+
+	// then
+	assert.NoError(t, encodeErr)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, expected, actual)
+}
+
+func Test_MarshallableColor_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected []byte
+	}{
+		{
+			name:     `GIVEN Red WHEN MarshalBinary THEN encoded Red code`,
+			color:    color.Red.ToMarshallable(),
+			expected: []byte{1},
+		},
+		{
+			name:     `GIVEN nil WHEN MarshalBinary THEN empty`,
+			color:    color.MarshallableColor{},
+			expected: []byte{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			data, err := tt.color.MarshalBinary()
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, data)
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN empty data WHEN UnmarshalBinary THEN nil`,
+			data: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN unknown code WHEN UnmarshalBinary THEN error`,
+			data: []byte{9},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN malformed code WHEN UnmarshalBinary THEN error`,
+			data: []byte{0x80},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.EqualError(t, r.err, "could not unmarshal Color from binary: malformed code")
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var marshallable color.MarshallableColor
+			err := marshallable.UnmarshalBinary(tt.data)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
@@ -0,0 +1,390 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithset"
+)
+
+func Test_ColorSet(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`GIVEN EmptySet WHEN Len THEN 0`, func(t *testing.T) {
+		t.Parallel()
+		// expect
+		assert.Equal(t, 0, color.EmptySet().Len())
+		assert.Equal(t, color.ColorSet{}, color.EmptySet())
+	})
+
+	t.Run(`GIVEN AllSet WHEN All THEN all the values in declaration order`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		values := slices.Collect(color.AllSet().All())
+
+		// then
+		assert.Equal(t, color.Values(), values)
+		assert.Equal(t, 4, color.AllSet().Len())
+	})
+
+	t.Run(`GIVEN values added in any order WHEN All THEN values in declaration order`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		set := color.EmptySet().Add(color.Blue, color.Red, color.Blue)
+
+		// when
+		values := slices.Collect(set.All())
+
+		// then
+		assert.Equal(t, []color.Color{color.Red, color.Blue}, values)
+		assert.Equal(t, 2, set.Len())
+	})
+
+	t.Run(`GIVEN set WHEN Add THEN the set is not modified`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		set := color.EmptySet().Add(color.Red)
+
+		// when
+		added := set.Add(color.Green)
+
+		// then
+		assert.False(t, set.Contains(color.Green))
+		assert.True(t, added.Contains(color.Green))
+	})
+
+	t.Run(`GIVEN set WHEN Remove THEN value is not contained`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		set := color.AllSet().Remove(color.Red, color.Green)
+
+		// then
+		assert.Equal(t, color.EmptySet().Add(color.Undefined, color.Blue), set)
+		assert.False(t, set.Contains(color.Red))
+	})
+
+	t.Run(`GIVEN nil WHEN Add and Contains THEN nil is ignored`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		set := color.EmptySet().Add(nil)
+
+		// then
+		assert.Equal(t, color.EmptySet(), set)
+		assert.False(t, set.Contains(nil))
+	})
+
+	t.Run(`GIVEN two sets WHEN Union, Intersect and Difference THEN set algebra results`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		a := color.EmptySet().Add(color.Red, color.Green)
+		b := color.EmptySet().Add(color.Green, color.Blue)
+
+		// expect
+		assert.Equal(t, color.EmptySet().Add(color.Red, color.Green, color.Blue), a.Union(b))
+		assert.Equal(t, color.EmptySet().Add(color.Green), a.Intersect(b))
+		assert.Equal(t, color.EmptySet().Add(color.Red), a.Difference(b))
+	})
+
+	t.Run(`GIVEN set WHEN String THEN comma-separated names`, func(t *testing.T) {
+		t.Parallel()
+		// expect
+		assert.Equal(t, "Red,Blue", color.EmptySet().Add(color.Blue, color.Red).String())
+		assert.Empty(t, color.EmptySet().String())
+	})
+}
+
+func Test_ColorSet_JSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		set      color.ColorSet
+		expected string
+	}{
+		{
+			name:     `GIVEN empty set WHEN Marshal and Unmarshal THEN empty array`,
+			set:      color.EmptySet(),
+			expected: `[]`,
+		},
+		{
+			name:     `GIVEN set WHEN Marshal and Unmarshal THEN array of names in declaration order`,
+			set:      color.EmptySet().Add(color.Blue, color.Red),
+			expected: `["Red","Blue"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			output, err := json.Marshal(tt.set)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(output))
+
+			// when
+			var set color.ColorSet
+			err = json.Unmarshal(output, &set)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.set, set)
+		})
+	}
+
+	t.Run(`GIVEN null WHEN Unmarshal THEN empty set`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		set := color.AllSet()
+
+		// when
+		err := json.Unmarshal([]byte(`null`), &set)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, color.EmptySet(), set)
+	})
+
+	t.Run(`GIVEN unknown name WHEN Unmarshal THEN error`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		var set color.ColorSet
+
+		// when
+		err := json.Unmarshal([]byte(`["Red","Purple"]`), &set)
+
+		// then
+		assert.ErrorIs(t, err, color.ErrInvalidColor)
+	})
+}
+
+func Test_ColorSet_Text(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected color.ColorSet
+	}{
+		{
+			name:     `GIVEN empty text WHEN UnmarshalText THEN empty set`,
+			input:    "",
+			expected: color.EmptySet(),
+		},
+		{
+			name:     `GIVEN comma-separated names WHEN UnmarshalText THEN set`,
+			input:    "Blue,Red",
+			expected: color.EmptySet().Add(color.Red, color.Blue),
+		},
+		{
+			name:     `GIVEN unknown name WHEN UnmarshalText THEN Undefined`,
+			input:    "Red,Purple",
+			expected: color.EmptySet().Add(color.Undefined, color.Red),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			var set color.ColorSet
+
+			// when
+			err := set.UnmarshalText([]byte(tt.input))
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, set)
+		})
+	}
+
+	t.Run(`GIVEN set WHEN MarshalText THEN comma-separated names in declaration order`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		text, err := color.EmptySet().Add(color.Blue, color.Red).MarshalText()
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Red,Blue", string(text))
+	})
+}
+
+func Test_ColorSet_SQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		src      any
+		expected color.ColorSet
+	}{
+		{
+			name:     `GIVEN NULL WHEN Scan THEN empty set`,
+			src:      nil,
+			expected: color.EmptySet(),
+		},
+		{
+			name:     `GIVEN comma-separated names string WHEN Scan THEN set`,
+			src:      "Green,Blue",
+			expected: color.EmptySet().Add(color.Green, color.Blue),
+		},
+		{
+			name:     `GIVEN comma-separated names bytes WHEN Scan THEN set`,
+			src:      []byte("Red"),
+			expected: color.EmptySet().Add(color.Red),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			set := color.AllSet()
+
+			// when
+			err := set.Scan(tt.src)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, set)
+		})
+	}
+
+	t.Run(`GIVEN unknown name WHEN Scan THEN error`, func(t *testing.T) {
+		t.Parallel()
+		// given
+		var set color.ColorSet
+
+		// when
+		err := set.Scan("Red,Purple")
+
+		// then
+		assert.ErrorIs(t, err, color.ErrInvalidColor)
+	})
+
+	t.Run(`GIVEN set WHEN Value THEN comma-separated names`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		value, err := color.EmptySet().Add(color.Blue, color.Green).Value()
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Green,Blue", value)
+	})
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Strict(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN Green AND empty JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN Green AND null JSON WHEN UnmarshalJSON THEN unchanged`,
+			json: []byte(`null`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\u0052ed" JSON WHEN UnmarshalJSON THEN Red`,
+			json: []byte(`"\u0052ed"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN number JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`1`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			marshallable := color.Green.ToJSONMarshallable()
+
+			// when
+			err := marshallable.UnmarshalJSON(tt.json)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithsqlmarshalling"
+)
+
+func Test_MarshallableColor_Scan(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		src  any
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN "Red" string WHEN Scan THEN Red`,
+			src:  "Red",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN "Blue" bytes WHEN Scan THEN Blue`,
+			src:  []byte("Blue"),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Blue, r.color)
+			},
+		},
+		{
+			name: `GIVEN nil WHEN Scan THEN zero`,
+			src:  nil,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN "InvalidColor" WHEN Scan THEN error`,
+			src:  "InvalidColor",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var invalidColorNameError color.InvalidColorNameError
+				assert.ErrorAs(t, r.err, &invalidColorNameError)
+				assert.Equal(t, "could not scan Color from SQL\ninvalid Color name: \"InvalidColor\"", r.err.Error())
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN int64 WHEN Scan THEN error`,
+			src:  int64(1),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.EqualError(t, r.err, "could not scan Color from SQL value of type int64")
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var marshallable color.MarshallableColor
+			err := marshallable.Scan(tt.src)
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_Value(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected driver.Value
+	}{
+		{
+			name:     `GIVEN Green WHEN Value THEN "Green"`,
+			color:    color.Green.ToMarshallable(),
+			expected: "Green",
+		},
+		{
+			name:     `GIVEN zero WHEN Value THEN nil`,
+			color:    color.MarshallableColor{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			value, err := tt.color.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func Test_MarshallableColor_SQLInterfaces(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*sql.Scanner)(nil), &color.MarshallableColor{})
+	assert.Implements(t, (*driver.Valuer)(nil), color.MarshallableColor{})
+}
@@ -0,0 +1,162 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithtextmarshalling"
+)
+
+func Test_MarshallableColor_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected string
+	}{
+		{
+			name:     `GIVEN Red WHEN MarshalText THEN "Red"`,
+			color:    color.Red.ToMarshallable(),
+			expected: "Red",
+		},
+		{
+			name:     `GIVEN Blue WHEN MarshalText THEN "Blue"`,
+			color:    color.Blue.ToMarshallable(),
+			expected: "Blue",
+		},
+		{
+			name:     `GIVEN zero WHEN MarshalText THEN empty`,
+			color:    color.MarshallableColor{},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			text, err := tt.color.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(text))
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		text string
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN "Green" WHEN UnmarshalText THEN Green`,
+			text: "Green",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN empty text WHEN UnmarshalText THEN zero`,
+			text: "",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN "InvalidColor" WHEN UnmarshalText THEN error`,
+			text: "InvalidColor",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.Equal(t, "could not unmarshal Color from text\ninvalid Color name: \"InvalidColor\"", r.err.Error())
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var marshallable color.MarshallableColor
+			err := marshallable.UnmarshalText([]byte(tt.text))
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_JSONMapKey(t *testing.T) {
+	t.Parallel()
+
+	// given
+	counts := map[color.MarshallableColor]int{
+		color.Red.ToMarshallable():  1,
+		color.Blue.ToMarshallable(): 2,
+	}
+
+	// when
+	marshalled, marshalErr := json.Marshal(counts)
+	var unmarshalled map[color.MarshallableColor]int
+	unmarshalErr := json.Unmarshal(marshalled, &unmarshalled)
+
+	// then
+	assert.NoError(t, marshalErr)
+	assert.JSONEq(t, `{"Red": 1, "Blue": 2}`, string(marshalled))
+	assert.NoError(t, unmarshalErr)
+	assert.Equal(t, counts, unmarshalled)
+}
+
+func Test_MarshallableColor_FlagTextVar(t *testing.T) {
+	t.Parallel()
+
+	// given
+	var marshallable color.MarshallableColor
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.TextVar(&marshallable, "color", color.Red.ToMarshallable(), "color")
+
+	// when
+	err := flags.Parse([]string{"-color", "Blue"})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, color.Blue, marshallable.ToEnum())
+}
@@ -0,0 +1,77 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithundefinedandtextmarshallingniltoundefined"
+)
+
+func Test_MarshallableColor_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		expected color.Color
+	}{
+		{
+			name:     `GIVEN "Green" WHEN UnmarshalText THEN Green`,
+			text:     "Green",
+			expected: color.Green,
+		},
+		{
+			name:     `GIVEN empty text WHEN UnmarshalText THEN Undefined`,
+			text:     "",
+			expected: color.Undefined,
+		},
+		{
+			name:     `GIVEN "InvalidColor" WHEN UnmarshalText THEN Undefined`,
+			text:     "InvalidColor",
+			expected: color.Undefined,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var marshallable color.MarshallableColor
+			err := marshallable.UnmarshalText([]byte(tt.text))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, marshallable.ToEnum())
+		})
+	}
+}
+
+func Test_MarshallableColor_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	text, err := color.Undefined.ToMarshallable().MarshalText()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Undefined", string(text))
+}
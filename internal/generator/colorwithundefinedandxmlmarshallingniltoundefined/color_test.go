@@ -0,0 +1,162 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithundefinedandxmlmarshallingniltoundefined"
+)
+
+type swatch struct {
+	XMLName xml.Name                `xml:"swatch"`
+	Tone    color.MarshallableColor `xml:"tone,attr"`
+	Color   color.MarshallableColor `xml:"color"`
+}
+
+func Test_MarshallableColor_MarshalXML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		swatch   swatch
+		expected string
+	}{
+		{
+			name:     `GIVEN Red element and Blue attribute WHEN Marshal THEN element and attribute`,
+			swatch:   swatch{Tone: color.Blue.ToMarshallable(), Color: color.Red.ToMarshallable()},
+			expected: `<swatch tone="Blue"><color>Red</color></swatch>`,
+		},
+		{
+			name:     `GIVEN nil element and attribute WHEN Marshal THEN both omitted`,
+			swatch:   swatch{},
+			expected: `<swatch></swatch>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			content, err := xml.Marshal(tt.swatch)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalXML(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		tone  color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		xml  string
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN Red element and Blue attribute WHEN Unmarshal THEN Red and Blue`,
+			xml:  `<swatch tone="Blue"><color>Red</color></swatch>`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+				assert.Equal(t, color.Blue, r.tone)
+			},
+		},
+		{
+			name: `GIVEN missing element and attribute WHEN Unmarshal THEN nil`,
+			xml:  `<swatch></swatch>`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Nil(t, r.color)
+				assert.Nil(t, r.tone)
+			},
+		},
+		{
+			name: `GIVEN empty element WHEN Unmarshal THEN Undefined`,
+			xml:  `<swatch><color></color></swatch>`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.color)
+			},
+		},
+		{
+			name: `GIVEN self-closing element WHEN Unmarshal THEN Undefined`,
+			xml:  `<swatch><color/></swatch>`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.color)
+			},
+		},
+		{
+			name: `GIVEN empty attribute WHEN Unmarshal THEN Undefined`,
+			xml:  `<swatch tone=""></swatch>`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.tone)
+			},
+		},
+		{
+			name: `GIVEN "Purple" element and attribute WHEN Unmarshal THEN Undefined`,
+			xml:  `<swatch tone="Purple"><color>Purple</color></swatch>`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.color)
+				assert.Equal(t, color.Undefined, r.tone)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var s swatch
+			err := xml.Unmarshal([]byte(tt.xml), &s)
+
+			// then
+			tt.then(t, result{
+				color: s.Color.ToEnum(),
+				tone:  s.Tone.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
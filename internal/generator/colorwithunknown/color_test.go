@@ -0,0 +1,356 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithunknown"
+)
+
+func Test_OfOrUnknown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expectedName  string
+		expectedKnown bool
+	}{
+		{
+			name:          `GIVEN Red WHEN OfOrUnknown THEN Red`,
+			input:         "Red",
+			expectedName:  "Red",
+			expectedKnown: true,
+		},
+		{
+			name:          `GIVEN Red in lower case WHEN OfOrUnknown THEN Red`,
+			input:         "red",
+			expectedName:  "Red",
+			expectedKnown: true,
+		},
+		{
+			name:          `GIVEN unknown name WHEN OfOrUnknown THEN unknown value keeping the name`,
+			input:         "Purple",
+			expectedName:  "Purple",
+			expectedKnown: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			value := color.OfOrUnknown(tt.input)
+
+			// then
+			assert.Equal(t, tt.expectedName, value.String())
+			assert.Equal(t, tt.expectedKnown, value.IsKnown())
+		})
+	}
+}
+
+func Test_Unknown(t *testing.T) {
+	t.Parallel()
+
+	// given
+	unknown := color.OfOrUnknown("Purple")
+
+	t.Run(`GIVEN unknown value WHEN Values THEN unknown value is excluded`, func(t *testing.T) {
+		t.Parallel()
+		// expect
+		assert.False(t, slices.Contains(color.Values(), unknown))
+	})
+
+	t.Run(`GIVEN unknown value WHEN Ordinal THEN -1`, func(t *testing.T) {
+		t.Parallel()
+		// expect
+		assert.Equal(t, -1, unknown.Ordinal())
+	})
+
+	t.Run(`GIVEN unknown value WHEN Next and Prev THEN false`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		_, nextOk := color.Next(unknown)
+		_, prevOk := color.Prev(unknown)
+
+		// then
+		assert.False(t, nextOk)
+		assert.False(t, prevOk)
+	})
+
+	t.Run(`GIVEN unknown values of the same name WHEN compared THEN equal`, func(t *testing.T) {
+		t.Parallel()
+		// expect
+		assert.Equal(t, unknown, color.OfOrUnknown("Purple"))
+		assert.NotEqual(t, unknown, color.OfOrUnknown("Orange"))
+	})
+
+	t.Run(`GIVEN unknown value WHEN ToMarshallable THEN unknown value is kept`, func(t *testing.T) {
+		t.Parallel()
+		// expect
+		assert.Equal(t, unknown, unknown.ToMarshallable().ToEnum())
+		assert.Equal(t, unknown, unknown.ToJSONMarshallable().ToEnum())
+	})
+
+	t.Run(`GIVEN unknown name WHEN Of THEN error`, func(t *testing.T) {
+		t.Parallel()
+		// when
+		_, err := color.Of("Purple")
+
+		// then
+		assert.ErrorIs(t, err, color.ErrInvalidColor)
+	})
+}
+
+func Test_MarshallableColor_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	codecs := []struct {
+		name      string
+		encode    func(name string) string
+		unmarshal func(input string, marshallable *color.MarshallableColor) error
+		marshal   func(marshallable color.MarshallableColor) (string, error)
+	}{
+		{
+			name:   "JSON",
+			encode: func(name string) string { return `"` + name + `"` },
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return json.Unmarshal([]byte(input), marshallable)
+			},
+			marshal: func(marshallable color.MarshallableColor) (string, error) {
+				output, err := json.Marshal(marshallable)
+				return string(output), err
+			},
+		},
+		{
+			name:   "text",
+			encode: func(name string) string { return name },
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return marshallable.UnmarshalText([]byte(input))
+			},
+			marshal: func(marshallable color.MarshallableColor) (string, error) {
+				output, err := marshallable.MarshalText()
+				return string(output), err
+			},
+		},
+		{
+			name:   "YAML",
+			encode: func(name string) string { return name + "\n" },
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return yaml.Unmarshal([]byte(input), marshallable)
+			},
+			marshal: func(marshallable color.MarshallableColor) (string, error) {
+				output, err := yaml.Marshal(marshallable)
+				return string(output), err
+			},
+		},
+		{
+			name:   "XML",
+			encode: func(name string) string { return "<MarshallableColor>" + name + "</MarshallableColor>" },
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return xml.Unmarshal([]byte(input), marshallable)
+			},
+			marshal: func(marshallable color.MarshallableColor) (string, error) {
+				output, err := xml.Marshal(marshallable)
+				return string(output), err
+			},
+		},
+		{
+			name:   "binary",
+			encode: func(name string) string { return name },
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return marshallable.UnmarshalBinary([]byte(input))
+			},
+			marshal: func(marshallable color.MarshallableColor) (string, error) {
+				output, err := marshallable.MarshalBinary()
+				return string(output), err
+			},
+		},
+		{
+			name:   "SQL",
+			encode: func(name string) string { return name },
+			unmarshal: func(input string, marshallable *color.MarshallableColor) error {
+				return marshallable.Scan(input)
+			},
+			marshal: func(marshallable color.MarshallableColor) (string, error) {
+				output, err := marshallable.Value()
+				name, _ := output.(string)
+				return name, err
+			},
+		},
+	}
+
+	for _, codec := range codecs {
+		tests := []struct {
+			name          string
+			input         string
+			expectedKnown bool
+		}{
+			{
+				name:          `GIVEN Red WHEN Unmarshal and Marshal THEN Red`,
+				input:         "Red",
+				expectedKnown: true,
+			},
+			{
+				name:          `GIVEN unknown name WHEN Unmarshal and Marshal THEN unknown name is preserved`,
+				input:         "Purple",
+				expectedKnown: false,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(codec.name+" "+tt.name, func(t *testing.T) {
+				t.Parallel()
+				// given
+				var marshallable color.MarshallableColor
+
+				// when
+				err := codec.unmarshal(codec.encode(tt.input), &marshallable)
+
+				// then
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input, marshallable.ToEnum().String())
+				assert.Equal(t, tt.expectedKnown, marshallable.ToEnum().IsKnown())
+
+				// when
+				output, err := codec.marshal(marshallable)
+
+				// then
+				assert.NoError(t, err)
+				assert.Equal(t, codec.encode(tt.input), output)
+			})
+		}
+	}
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Null(t *testing.T) {
+	t.Parallel()
+
+	// given
+	var marshallable color.MarshallableColor
+
+	// when
+	err := json.Unmarshal([]byte("null"), &marshallable)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, color.Undefined, marshallable.ToEnum())
+}
+
+func Test_MarshallableColor_UnmarshalJSON_Strict(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		json []byte
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN Green AND empty JSON WHEN UnmarshalJSON THEN Undefined`,
+			json: []byte{},
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.color)
+			},
+		},
+		{
+			name: `GIVEN Green AND null JSON WHEN UnmarshalJSON THEN Undefined`,
+			json: []byte(`null`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Undefined, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\u0052ed" JSON WHEN UnmarshalJSON THEN Red`,
+			json: []byte(`"\u0052ed"`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN unquoted Red JSON WHEN UnmarshalJSON THEN syntax error`,
+			json: []byte(`Red`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var syntaxError *json.SyntaxError
+				assert.ErrorAs(t, r.err, &syntaxError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN number JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`1`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN object JSON WHEN UnmarshalJSON THEN type error`,
+			json: []byte(`{"name":"Red"}`),
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			marshallable := color.Green.ToJSONMarshallable()
+
+			// when
+			err := marshallable.UnmarshalJSON(tt.json)
+
+			// then
+			tt.then(t, result{
+				color: marshallable.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package color_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	color "github.com/tompaz3/go-enumerator/internal/generator/colorwithyamlmarshalling"
+)
+
+type palette struct {
+	Name  string                  `yaml:"name"`
+	Color color.MarshallableColor `yaml:"color"`
+}
+
+func Test_MarshallableColor_MarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		color    color.MarshallableColor
+		expected string
+	}{
+		{
+			name:     `GIVEN Red WHEN Marshal THEN Red`,
+			color:    color.Red.ToMarshallable(),
+			expected: "name: palette\ncolor: Red\n",
+		},
+		{
+			name:     `GIVEN nil WHEN Marshal THEN null`,
+			color:    color.MarshallableColor{},
+			expected: "name: palette\ncolor: null\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			content, err := yaml.Marshal(palette{Name: "palette", Color: tt.color})
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		color color.Color
+		err   error
+	}
+
+	tests := []struct {
+		name string
+		yaml string
+		then func(t *testing.T, r result)
+	}{
+		{
+			name: `GIVEN plain Red WHEN Unmarshal THEN Red`,
+			yaml: "color: Red",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Red, r.color)
+			},
+		},
+		{
+			name: `GIVEN quoted "Green" WHEN Unmarshal THEN Green`,
+			yaml: `color: "Green"`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Green, r.color)
+			},
+		},
+		{
+			name: `GIVEN escaped "\x42lue" WHEN Unmarshal THEN Blue`,
+			yaml: `color: "\x42lue"`,
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.NoError(t, r.err)
+				assert.Equal(t, color.Blue, r.color)
+			},
+		},
+		{
+			name: `GIVEN number at line 2 WHEN Unmarshal THEN type error with line number`,
+			yaml: "name: palette\ncolor: 1",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *yaml.TypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Equal(t, []string{"line 2: cannot unmarshal !!int `1` into Color"}, typeError.Errors)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN sequence WHEN Unmarshal THEN type error`,
+			yaml: "color: [Red]",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				var typeError *yaml.TypeError
+				assert.ErrorAs(t, r.err, &typeError)
+				assert.Nil(t, r.color)
+			},
+		},
+		{
+			name: `GIVEN "Purple" at line 2 WHEN Unmarshal THEN error with line number`,
+			yaml: "name: palette\ncolor: Purple",
+			then: func(t *testing.T, r result) {
+				t.Helper()
+				assert.ErrorIs(t, r.err, color.ErrInvalidColor)
+				assert.ErrorContains(t, r.err, "could not unmarshal Color from YAML at line 2")
+				assert.Nil(t, r.color)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			var p palette
+			err := yaml.Unmarshal([]byte(tt.yaml), &p)
+
+			// then
+			tt.then(t, result{
+				color: p.Color.ToEnum(),
+				err:   err,
+			})
+		})
+	}
+}
+
+func Test_MarshallableColor_UnmarshalYAML_Null(t *testing.T) {
+	t.Parallel()
+
+	// given
+	var node yaml.Node
+	assert.NoError(t, yaml.Unmarshal([]byte("null"), &node))
+
+	// when
+	var marshallable color.MarshallableColor
+	err := marshallable.UnmarshalYAML(node.Content[0])
+
+	// then
+	assert.NoError(t, err)
+	assert.Nil(t, marshallable.ToEnum())
+}
@@ -0,0 +1,131 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import "strconv"
+
+// deprecationGenerator generates the IsDeprecated method, ActiveValues and OfStrict functions
+// for the enums with deprecated values.
+type deprecationGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newDeprecationGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *deprecationGenerator {
+	return &deprecationGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *deprecationGenerator) imports() []string {
+	if !g.enum.hasDeprecations() {
+		return nil
+	}
+	return []string{"fmt"}
+}
+
+func (g *deprecationGenerator) generateIsDeprecatedDeclaration() {
+	if !g.enum.hasDeprecations() {
+		return
+	}
+	g.writer.Line("\tIsDeprecated() bool")
+}
+
+func (g *deprecationGenerator) generateDeprecatedField() {
+	if !g.enum.hasDeprecations() {
+		return
+	}
+	g.writer.Line("\tdeprecated bool")
+}
+
+// valueLiteralField returns the deprecated field of the value struct literal.
+func (g *deprecationGenerator) valueLiteralField(value generationValue) string {
+	if !g.enum.hasDeprecations() {
+		return ""
+	}
+	return ", deprecated: " + strconv.FormatBool(value.deprecated != "")
+}
+
+func (g *deprecationGenerator) generateIsDeprecatedMethod() {
+	if !g.enum.hasDeprecations() {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// IsDeprecated reports whether the value is deprecated.")
+	w.Line("// Deprecated values are still accepted by Of, but rejected by OfStrict.")
+	w.Line("func (b " + e.baseStruct + ") IsDeprecated() bool {")
+	w.Line("\treturn b.deprecated")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *deprecationGenerator) generateActiveValues() {
+	if !g.enum.hasDeprecations() {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// ActiveValues returns all the values of " + e.Type + " which are not deprecated")
+	w.Line("// IMPORTANT: Generates a new slice every time to avoid overwriting enum values")
+	w.Line("func ActiveValues() []" + e.Type + " {")
+	w.Line("\treturn []" + e.Type + "{")
+	for _, value := range e.values {
+		if value.deprecated != "" {
+			continue
+		}
+		w.Line("\t\t" + value.identifier + ",")
+	}
+	w.Line("\t}")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *deprecationGenerator) generateOfStrict() {
+	if !g.enum.hasDeprecations() {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// OfStrict maps the name to " + e.Type + " value the same way Of does,")
+	w.Line("// but deprecated values are rejected with error matching " + e.invalidNameErrorSentinel + ".")
+	w.Line("func OfStrict(name string) (" + e.Type + ", error) {")
+	w.Line("\tvalue, err := Of(name)")
+	w.Line("\tif err != nil {")
+	w.Line("\t\treturn nil, err")
+	w.Line("\t}")
+	w.Line("\tif value.IsDeprecated() {")
+	w.Line("\t\treturn nil, fmt.Errorf(\"%w: %q is deprecated\", " + e.invalidNameErrorSentinel + ", value.String())")
+	w.Line("\t}")
+	w.Line("\treturn value, nil")
+	w.Line("}")
+	w.LineBreak()
+}
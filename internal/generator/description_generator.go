@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// descriptionGenerator generates the type and value doc comments and the Description method.
+type descriptionGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newDescriptionGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *descriptionGenerator {
+	return &descriptionGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+// generateTypeDoc generates the enum interface doc comment.
+func (g *descriptionGenerator) generateTypeDoc() {
+	g.generateDoc("", g.enum.Description)
+}
+
+// generateValueDoc generates the value doc comment, to be placed in the values var block.
+// The deprecation message is appended as the "Deprecated: " paragraph recognised by staticcheck.
+func (g *descriptionGenerator) generateValueDoc(value generationValue) {
+	doc := value.description
+	if value.deprecated != "" {
+		doc = strings.TrimSpace(doc + "\n\nDeprecated: " + value.deprecated)
+	}
+	g.generateDoc("\t", doc)
+}
+
+func (g *descriptionGenerator) generateDoc(indent, description string) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return
+	}
+	for line := range strings.SplitSeq(description, "\n") {
+		line = strings.TrimRightFunc(line, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == '\r'
+		})
+		if line == "" {
+			g.writer.Line(indent + "//")
+			continue
+		}
+		g.writer.Line(indent + "// " + line)
+	}
+}
+
+func (g *descriptionGenerator) generateDescriptionDeclaration() {
+	if !g.enum.hasDescriptions() {
+		return
+	}
+	g.writer.Line("\tDescription() string")
+}
+
+func (g *descriptionGenerator) generateDescriptionField() {
+	if !g.enum.hasDescriptions() {
+		return
+	}
+	g.writer.Line("\tdescription string")
+}
+
+// valueLiteralField returns the description field of the value struct literal.
+func (g *descriptionGenerator) valueLiteralField(value generationValue) string {
+	if !g.enum.hasDescriptions() {
+		return ""
+	}
+	return ", description: " + strconv.Quote(value.description)
+}
+
+func (g *descriptionGenerator) generateDescriptionMethod() {
+	if !g.enum.hasDescriptions() {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// Description returns the value documentation, empty if the value is not described.")
+	w.Line("func (b " + e.baseStruct + ") Description() string {")
+	w.Line("\treturn b.description")
+	w.Line("}")
+	w.LineBreak()
+}
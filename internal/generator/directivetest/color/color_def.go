@@ -0,0 +1,23 @@
+//go:build ignore
+
+package color
+
+//enumerator:enum json nil-to-undefined undefined=Undefined sumtype copyright=../../../../LICENSE
+type Color struct{}
+
+const (
+	Undefined = "Undefined"
+	Red       = "Red"
+	Green     = "Green"
+	Blue      = "Blue"
+)
+
+// Shape is generated to its own package, as enums can't share a package directory.
+//
+//enumerator:enum json copyright=../../../../LICENSE destination=./shape/shape.go
+type Shape struct{}
+
+const (
+	Circle = "Circle"
+	Square = "Square"
+)
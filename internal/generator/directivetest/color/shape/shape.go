@@ -0,0 +1,299 @@
+// MIT License
+// 
+// Copyright (c) 2024-2026 Tomasz Paździurek
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// 
+
+package color
+
+// Code generated by github.com/tompaz3/go-enumerator DO NOT EDIT.
+// generate go-enumerator -source ./color/color_def.go
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+)
+
+// Shape is generated to its own package, as enums can't share a package directory.
+type Shape interface {
+	sealedShape()
+	String() string
+	Ordinal() int
+	ToMarshallable() MarshallableShape
+	ToJSONMarshallable() MarshallableShape
+}
+
+type baseShape struct {
+	name    string
+	ordinal int
+}
+
+func (b baseShape) sealedShape() {}
+
+func (b baseShape) String() string {
+	return b.name
+}
+
+// Ordinal returns the value position in Values, starting from 0.
+func (b baseShape) Ordinal() int {
+	return b.ordinal
+}
+
+// LogValue implements slog.LogValuer logging the enum name.
+func (b baseShape) LogValue() slog.Value {
+	return slog.StringValue(b.name)
+}
+
+// Format implements fmt.Formatter.
+// %v and %s format the name, %q the quoted name, %d the ordinal and %+v the Shape.name form.
+func (b baseShape) Format(state fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && state.Flag('+'):
+		_, _ = fmt.Fprint(state, "Shape."+b.name)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.name)
+	case verb == 'd':
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.ordinal)
+	default:
+		_, _ = fmt.Fprintf(state, "%%!%c(Shape=%s)", verb, b.name)
+	}
+}
+
+var (
+	Circle = baseShape{name: "Circle", ordinal: 0}
+	Square = baseShape{name: "Square", ordinal: 1}
+
+	allValuesByString = map[string]Shape{
+		Circle.String(): Circle,
+		Square.String(): Square,
+	}
+
+	valuesByOrdinal = [2]Shape{
+		Circle,
+		Square,
+	}
+)
+
+// Values returns all possible values of Shape
+// IMPORTANT: Generates a new slice every time to avoid overwriting enum values
+func Values() []Shape {
+	return []Shape{
+		Circle,
+		Square,
+	}
+}
+
+// FromOrdinal returns the value at the ordinal position,
+// ordinals out of range are rejected with error matching ErrInvalidShape.
+func FromOrdinal(ordinal int) (Shape, error) {
+	if ordinal < 0 || ordinal >= len(valuesByOrdinal) {
+		return nil, fmt.Errorf("%w: ordinal %d out of range [0, %d)", ErrInvalidShape, ordinal, len(valuesByOrdinal))
+	}
+	return valuesByOrdinal[ordinal], nil
+}
+
+// Compare compares the values by ordinal, to be used with slices.SortFunc.
+// nil is ordered before all the values.
+func Compare(a, b Shape) int {
+	return cmp.Compare(ordinalOf(a), ordinalOf(b))
+}
+
+func ordinalOf(value Shape) int {
+	if value == nil {
+		return -1
+	}
+	return value.Ordinal()
+}
+
+// First returns the first declared value.
+func First() Shape {
+	return valuesByOrdinal[0]
+}
+
+// Last returns the last declared value.
+func Last() Shape {
+	return valuesByOrdinal[len(valuesByOrdinal)-1]
+}
+
+// Next returns the value declared after the value, false if the value is the last one or nil.
+func Next(value Shape) (Shape, bool) {
+	if value == nil || value.Ordinal() == len(valuesByOrdinal)-1 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()+1], true
+}
+
+// Prev returns the value declared before the value, false if the value is the first one or nil.
+func Prev(value Shape) (Shape, bool) {
+	if value == nil || value.Ordinal() == 0 {
+		return nil, false
+	}
+	return valuesByOrdinal[value.Ordinal()-1], true
+}
+
+// All iterates over ordinals and values in the declaration order without allocating.
+func All() iter.Seq2[int, Shape] {
+	return func(yield func(int, Shape) bool) {
+		for ordinal, value := range valuesByOrdinal {
+			if !yield(ordinal, value) {
+				return
+			}
+		}
+	}
+}
+
+func Of(name string) (Shape, error) {
+	if value, ok := allValuesByString[name]; ok {
+		return value, nil
+	}
+	return nil, newInvalidShapeNameError(name)
+}
+
+type MarshallableShape struct {
+	en Shape
+}
+
+func (b baseShape) ToMarshallable() MarshallableShape {
+	return MarshallableShape{en: b}
+}
+
+func (m MarshallableShape) ToEnum() Shape {
+	return m.en
+}
+
+func (b MarshallableShape) MarshalJSON() ([]byte, error) {
+	if b.en == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.en.String())
+}
+
+// UnmarshalJSON accepts JSON string and null tokens only.
+// Any other token is rejected with *json.UnmarshalTypeError or *json.SyntaxError.
+func (b *MarshallableShape) UnmarshalJSON(jsonBytes []byte) error {
+	if len(jsonBytes) == 0 {
+		return nil
+	}
+
+	if string(jsonBytes) == "null" {
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(jsonBytes, &name); err != nil {
+		return errors.Join(errors.New("could not unmarshal Shape from JSON"), err)
+	}
+
+	value, err := Of(name)
+	if err != nil {
+		return errors.Join(errors.New("could not unmarshal Shape from JSON"), err)
+	}
+	b.en = value
+	return nil
+}
+
+func (b baseShape) ToJSONMarshallable() MarshallableShape {
+	return MarshallableShape{en: b}
+}
+
+// ErrInvalidShape is matched by InvalidShapeNameError using errors.Is.
+var ErrInvalidShape = errors.New("invalid Shape")
+
+type InvalidShapeNameError struct {
+	name string
+}
+
+func (e InvalidShapeNameError) Error() string {
+	if suggestion, ok := e.Suggestion(); ok {
+		return "invalid Shape name: \"" + e.name + "\", did you mean \"" + suggestion + "\"?"
+	}
+	return "invalid Shape name: \"" + e.name + "\""
+}
+
+func (e InvalidShapeNameError) Is(target error) bool {
+	return target == ErrInvalidShape
+}
+
+// Name returns the name which did not match any Shape value.
+func (e InvalidShapeNameError) Name() string {
+	return e.name
+}
+
+// Type returns the enum type name.
+func (e InvalidShapeNameError) Type() string {
+	return "Shape"
+}
+
+// Allowed returns names of all the Shape values.
+func (e InvalidShapeNameError) Allowed() []string {
+	values := Values()
+	allowed := make([]string, 0, len(values))
+	for _, value := range values {
+		allowed = append(allowed, value.String())
+	}
+	return allowed
+}
+
+// Suggestion returns the allowed name closest to the invalid name (case-insensitive edit distance),
+// if there is one close enough.
+func (e InvalidShapeNameError) Suggestion() (string, bool) {
+	const maxDistance = 2
+	name := strings.ToLower(e.name)
+	suggestion, suggestionDistance := "", maxDistance+1
+	for _, allowed := range e.Allowed() {
+		distance := editDistance(name, strings.ToLower(allowed))
+		if distance < suggestionDistance && distance < utf8.RuneCountInString(allowed) {
+			suggestion, suggestionDistance = allowed, distance
+		}
+	}
+	return suggestion, suggestion != ""
+}
+
+func editDistance(source, target string) int {
+	sourceRunes, targetRunes := []rune(source), []rune(target)
+	previous := make([]int, len(targetRunes)+1)
+	current := make([]int, len(targetRunes)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(sourceRunes); i++ {
+		current[0] = i
+		for j := 1; j <= len(targetRunes); j++ {
+			cost := 1
+			if sourceRunes[i-1] == targetRunes[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(targetRunes)]
+}
+
+func newInvalidShapeNameError(name string) InvalidShapeNameError {
+	return InvalidShapeNameError{name: name}
+}
+
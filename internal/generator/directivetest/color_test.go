@@ -9,15 +9,40 @@ import (
 	_ "embed" // embed package is imported for go:embed directive
 )
 
-//go:generate go-enumerator -destination ./color/color.go -package color -type Color -values Undefined,Red,Green,Blue -undefined Undefined -marshal-json -unmarshal-json-to-undefined -copyright ../../../LICENSE -go-check-sumtype
+//go:generate go-enumerator -source ./color/color_def.go
 
 //go:embed color/expected_color.txt
 var expectedColor []byte
 
+//go:embed color/shape/expected_shape.txt
+var expectedShape []byte
+
 func Test_Generated(t *testing.T) {
 	t.Parallel()
 
-	content, err := os.ReadFile("./color/color.go")
-	assert.NoError(t, err)
-	assert.Equal(t, expectedColor, content)
+	tests := []struct {
+		name     string
+		path     string
+		expected []byte
+	}{
+		{
+			name:     `GIVEN annotated Color WHEN go generate THEN color.go generated`,
+			path:     "./color/color.go",
+			expected: expectedColor,
+		},
+		{
+			name:     `GIVEN annotated Shape in the same source WHEN go generate THEN shape.go generated to own package`,
+			path:     "./color/shape/shape.go",
+			expected: expectedShape,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
 }
@@ -23,7 +23,14 @@
 
 package generator
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"maps"
+	"slices"
+	"strings"
+)
 
 var (
 	ErrEmptyPackage                           = errors.New("package name is empty")
@@ -31,6 +38,34 @@ var (
 	ErrEmptyValues                            = errors.New("values are empty")
 	ErrUndefinedValueNotFound                 = errors.New("undefined value not found in values")
 	ErrUndefinedValueForUnmarshallingNotFound = errors.New("undefined value for unmarshalling not found")
+	ErrDefaultValueNotFound                   = errors.New("default value not found in values")
+	ErrDefaultValueForUnmarshallingNotFound   = errors.New("default value for unmarshalling not found")
+	ErrAmbiguousNilValue                      = errors.New("nil can't be unmarshalled to both default and undefined value")
+	ErrInvalidIdentifier                      = errors.New("value identifier is not a valid Go identifier")
+	ErrDuplicateIdentifier                    = errors.New("value identifier is duplicated")
+	ErrEmptyName                              = errors.New("value name is empty")
+	ErrDuplicateName                          = errors.New("value name is duplicated")
+	ErrUnknownNamingStrategy                  = errors.New("unknown naming strategy")
+	ErrAmbiguousNormalizedName                = errors.New("value names are equal after normalization")
+	ErrEmptyAlias                             = errors.New("value alias is empty")
+	ErrDuplicateAlias                         = errors.New("value alias collides with another name or alias")
+	ErrInvalidGraphQLName                     = errors.New("value GraphQL name is not a valid GraphQL name")
+	ErrDuplicateGraphQLName                   = errors.New("value GraphQL name is duplicated")
+	ErrMissingCode                            = errors.New("value code is missing, codes must be declared for all values")
+	ErrDuplicateCode                          = errors.New("value code is duplicated")
+	ErrNegativeBinaryCode                     = errors.New("value code is negative and can't be used as binary code")
+	ErrCodesForMarshallingNotFound            = errors.New("value codes for marshalling as code not found")
+	ErrInvalidAttributeName                   = errors.New("attribute name is not a valid Go identifier")
+	ErrReservedAttributeName                  = errors.New("attribute name collides with a generated method")
+	ErrDuplicateAttributeName                 = errors.New("attribute name is duplicated")
+	ErrUnknownAttributeType                   = errors.New("unknown attribute type")
+	ErrMissingAttributeValue                  = errors.New("value attribute is missing")
+	ErrUnknownAttribute                       = errors.New("value attribute is not declared")
+	ErrInvalidAttributeValue                  = errors.New("value attribute does not match the attribute type")
+	ErrSetSeparatorInName                     = errors.New("value name contains comma used as set separator")
+	ErrUnknownNotPreservable                  = errors.New("code and GraphQL marshalling can't preserve unknown values")
+	ErrSharedPackageDirectory                 = errors.New("enums can't be generated to the same package directory")
+	ErrSeparateFileWithoutDestination         = errors.New("GraphQL schema and JSON v2 files require a destination")
 )
 
 type Enum struct {
@@ -39,23 +74,266 @@ type Enum struct {
 
 	CopyrightFile string
 
-	Package string
-	Type    string
-	Values  []string
+	Package     string
+	Type        string
+	Description string
+	Values      []Value
+	Naming      NamingStrategy
+
+	Attributes []Attribute
 
 	UndefinedValue string
+	DefaultValue   string
+
+	Parsing         ParseOptions
+	Marshalling     MarshalOptions
+	PreserveUnknown bool
+	Nullable        bool
+	Set             bool
+	Flag            bool
+	CheckSumType    bool
+}
+
+// Value is a single enum value.
+// Identifier is the Go identifier of the value, Name is the value's string representation
+// returned by String() and accepted by Of. Name is derived from the Identifier
+// using the enum NamingStrategy when empty.
+// Aliases are additional (e.g. legacy) names accepted by Of, but never returned by String().
+// Code is an optional stable numeric code returned by Code() and accepted by OfCode,
+// declared either for all the values or for none of them.
+// Attributes hold the value of every enum Attribute by the attribute name.
+// Description is an optional documentation of the value, paragraphs are separated by blank lines.
+// Deprecated is the deprecation message of the value, deprecated values stay parseable by Of,
+// but are excluded from ActiveValues and rejected by OfStrict.
+type Value struct {
+	Identifier  string
+	Name        string
+	Aliases     []string
+	Code        *int
+	Attributes  map[string]any
+	Description string
+	Deprecated  string
+}
 
-	Marshalling  MarshalOptions
-	CheckSumType bool
+// NewValue creates a Value from either "Identifier" or "Identifier=name" definition.
+func NewValue(definition string) Value {
+	identifier, name, _ := strings.Cut(definition, "=")
+	return Value{
+		Identifier: identifier,
+		Name:       name,
+	}
+}
+
+// ParseOptions enable lenient parsing of value names in Of and OfOrUndefined.
+// The exact name is always matched first, String() keeps returning the canonical name.
+type ParseOptions struct {
+	IgnoreCase       bool
+	TrimSpace        bool
+	IgnoreSeparators bool
+}
+
+func (o ParseOptions) enabled() bool {
+	return o.IgnoreCase || o.TrimSpace || o.IgnoreSeparators
+}
+
+// normalize mirrors the generated normalizeName function.
+func (o ParseOptions) normalize(name string) string {
+	if o.TrimSpace {
+		name = strings.TrimSpace(name)
+	}
+	if o.IgnoreCase {
+		name = strings.ToLower(name)
+	}
+	if o.IgnoreSeparators {
+		name = strings.NewReplacer("-", "_", " ", "_").Replace(name)
+	}
+	return name
 }
 
 type MarshalOptions struct {
-	JSONOptions JSONMarshalOptions
+	JSONOptions    JSONMarshalOptions
+	TextOptions    TextMarshalOptions
+	YAMLOptions    YAMLMarshalOptions
+	XMLOptions     XMLMarshalOptions
+	BinaryOptions  BinaryMarshalOptions
+	GraphQLOptions GraphQLMarshalOptions
+	SQLOptions     SQLMarshalOptions
+}
+
+// enabled reports whether any marshalling format requires the MarshallableType wrapper.
+func (o MarshalOptions) enabled() bool {
+	return o.JSONOptions.Generate ||
+		o.TextOptions.Generate ||
+		o.YAMLOptions.Generate ||
+		o.XMLOptions.Generate ||
+		o.BinaryOptions.Generate ||
+		o.GraphQLOptions.Generate ||
+		o.SQLOptions.Generate
 }
 
+// unmarshalTargets are the codec options mapping nil and unknown input to the default and undefined values.
+type unmarshalTargets struct {
+	generate           bool
+	nilToDefault       bool
+	nilToUndefined     bool
+	unknownToUndefined bool
+}
+
+func (o MarshalOptions) unmarshalTargets() []unmarshalTargets {
+	return []unmarshalTargets{
+		{
+			generate:           o.JSONOptions.Generate,
+			nilToDefault:       o.JSONOptions.NilToDefault,
+			nilToUndefined:     o.JSONOptions.NilToUndefined,
+			unknownToUndefined: o.JSONOptions.unknownToUndefined(),
+		},
+		{
+			generate:           o.TextOptions.Generate,
+			nilToDefault:       o.TextOptions.NilToDefault,
+			nilToUndefined:     o.TextOptions.NilToUndefined,
+			unknownToUndefined: o.TextOptions.unknownToUndefined(),
+		},
+		{
+			generate:           o.YAMLOptions.Generate,
+			nilToDefault:       o.YAMLOptions.NilToDefault,
+			nilToUndefined:     o.YAMLOptions.NilToUndefined,
+			unknownToUndefined: o.YAMLOptions.unknownToUndefined(),
+		},
+		{
+			generate:           o.XMLOptions.Generate,
+			nilToDefault:       o.XMLOptions.NilToDefault,
+			nilToUndefined:     o.XMLOptions.NilToUndefined,
+			unknownToUndefined: o.XMLOptions.unknownToUndefined(),
+		},
+		{
+			generate:           o.SQLOptions.Generate,
+			nilToDefault:       o.SQLOptions.NullToDefault,
+			nilToUndefined:     o.SQLOptions.NullToUndefined,
+			unknownToUndefined: o.SQLOptions.UnknownToUndefined,
+		},
+	}
+}
+
+// JSONMarshalOptions configure json.Marshaler and json.Unmarshaler generation.
+// NilToUndefined unmarshals empty, null and unknown JSON strings to the undefined value.
+// NilToDefault unmarshals empty and null JSON to the default value instead,
+// UnknownToUndefined unmarshals unknown JSON strings (or codes) to the undefined value.
+// V2 additionally generates encoding/json/v2 MarshalJSONTo and UnmarshalJSONFrom methods
+// to a separate file built only with the jsonv2 GOEXPERIMENT.
+// AsCode marshals the value code as JSON number instead of the name.
 type JSONMarshalOptions struct {
-	Generate       bool
-	NilToUndefined bool
+	Generate           bool
+	NilToUndefined     bool
+	NilToDefault       bool
+	UnknownToUndefined bool
+	V2                 bool
+	AsCode             bool
+}
+
+// unknownToUndefined reports whether unknown names (or codes) are unmarshalled to the undefined value.
+func (o JSONMarshalOptions) unknownToUndefined() bool {
+	return o.NilToUndefined || o.UnknownToUndefined
+}
+
+// generateV2 reports whether encoding/json/v2 methods are generated.
+func (o JSONMarshalOptions) generateV2() bool {
+	return o.Generate && o.V2
+}
+
+// TextMarshalOptions configure encoding.TextMarshaler and encoding.TextUnmarshaler generation.
+// NilToUndefined unmarshals empty and unknown text to the undefined value.
+// NilToDefault unmarshals empty text to the default value instead,
+// UnknownToUndefined unmarshals unknown text to the undefined value.
+type TextMarshalOptions struct {
+	Generate           bool
+	NilToUndefined     bool
+	NilToDefault       bool
+	UnknownToUndefined bool
+}
+
+// unknownToUndefined reports whether unknown names are unmarshalled to the undefined value.
+func (o TextMarshalOptions) unknownToUndefined() bool {
+	return o.NilToUndefined || o.UnknownToUndefined
+}
+
+// YAMLMarshalOptions configure gopkg.in/yaml.v3 yaml.Marshaler and yaml.Unmarshaler generation.
+// NilToUndefined unmarshals null and unknown YAML strings to the undefined value.
+// NilToDefault unmarshals null to the default value instead,
+// UnknownToUndefined unmarshals unknown YAML strings to the undefined value.
+type YAMLMarshalOptions struct {
+	Generate           bool
+	NilToUndefined     bool
+	NilToDefault       bool
+	UnknownToUndefined bool
+}
+
+// unknownToUndefined reports whether unknown names are unmarshalled to the undefined value.
+func (o YAMLMarshalOptions) unknownToUndefined() bool {
+	return o.NilToUndefined || o.UnknownToUndefined
+}
+
+// XMLMarshalOptions configure xml.Marshaler, xml.Unmarshaler, xml.MarshalerAttr and xml.UnmarshalerAttr generation.
+// NilToUndefined unmarshals empty and unknown XML elements and attributes to the undefined value.
+// NilToDefault unmarshals empty XML elements and attributes to the default value instead,
+// UnknownToUndefined unmarshals unknown names to the undefined value.
+type XMLMarshalOptions struct {
+	Generate           bool
+	NilToUndefined     bool
+	NilToDefault       bool
+	UnknownToUndefined bool
+}
+
+// unknownToUndefined reports whether unknown names are unmarshalled to the undefined value.
+func (o XMLMarshalOptions) unknownToUndefined() bool {
+	return o.NilToUndefined || o.UnknownToUndefined
+}
+
+// BinaryMarshalOptions configure encoding.BinaryMarshaler, encoding.BinaryUnmarshaler,
+// gob.GobEncoder and gob.GobDecoder generation.
+// Numeric encodes the value code instead of its name, so it requires the codes,
+// the declaration index would change the meaning of the persisted data once the values are reordered.
+type BinaryMarshalOptions struct {
+	Generate bool
+	Numeric  bool
+}
+
+// GraphQLMarshalOptions configure gqlgen compatible MarshalGQL and UnmarshalGQL generation,
+// together with the GraphQL enum SDL generated to a separate .graphql file.
+type GraphQLMarshalOptions struct {
+	Generate bool
+}
+
+// SQLMarshalOptions configure sql.Scanner and driver.Valuer generation.
+// NullToUndefined scans NULL to the undefined value, NullToDefault scans NULL to the default value instead.
+// UnknownToUndefined scans unknown names (or codes) to the undefined value, they are rejected otherwise.
+// AsCode stores the value code (e.g. in a smallint column) instead of the name.
+type SQLMarshalOptions struct {
+	Generate           bool
+	NullToUndefined    bool
+	NullToDefault      bool
+	UnknownToUndefined bool
+	AsCode             bool
+}
+
+// hasCodes reports whether the values declare codes.
+func (e Enum) hasCodes() bool {
+	return slices.ContainsFunc(e.Values, func(value Value) bool {
+		return value.Code != nil
+	})
+}
+
+// hasDescriptions reports whether any of the values is described.
+func (e Enum) hasDescriptions() bool {
+	return slices.ContainsFunc(e.Values, func(value Value) bool {
+		return strings.TrimSpace(value.Description) != ""
+	})
+}
+
+// hasDeprecations reports whether any of the values is deprecated.
+func (e Enum) hasDeprecations() bool {
+	return slices.ContainsFunc(e.Values, func(value Value) bool {
+		return strings.TrimSpace(value.Deprecated) != ""
+	})
 }
 
 func (e Enum) validate() error {
@@ -68,28 +346,295 @@ func (e Enum) validate() error {
 	if len(e.Values) == 0 {
 		return ErrEmptyValues
 	}
+	if !e.Naming.valid() {
+		return ErrUnknownNamingStrategy
+	}
+	if err := e.validateValues(); err != nil {
+		return err
+	}
+	if err := e.validateCodes(); err != nil {
+		return err
+	}
+	if err := e.validateAttributes(); err != nil {
+		return err
+	}
+	if err := e.validateGraphQLNames(); err != nil {
+		return err
+	}
+	if err := e.validatePreserveUnknown(); err != nil {
+		return err
+	}
+	if err := e.validateSet(); err != nil {
+		return err
+	}
+	if err := e.validateDestination(); err != nil {
+		return err
+	}
 
 	return e.validateUndefined()
 }
 
-func (e Enum) validateUndefined() error {
-	if e.UndefinedValue != "" {
-		found := false
-		for _, value := range e.Values {
-			if value == e.UndefinedValue {
-				found = true
-				break
+// validateDestination checks the destination is set when the GraphQL schema or the JSON v2 methods
+// are generated to separate files, which would be mixed with the enum source on the standard output.
+func (e Enum) validateDestination() error {
+	if e.Destination != nil && len(*e.Destination) > 0 {
+		return nil
+	}
+	if e.Marshalling.JSONOptions.generateV2() || e.Marshalling.GraphQLOptions.Generate {
+		return ErrSeparateFileWithoutDestination
+	}
+	return nil
+}
+
+func (e Enum) validateValues() error {
+	identifiers := make(map[string]struct{}, len(e.Values))
+	// all names and aliases mapped to value identifiers
+	names := make(map[string]string, len(e.Values))
+	for _, value := range e.Values {
+		if !token.IsIdentifier(value.Identifier) {
+			return fmt.Errorf("%w: %q", ErrInvalidIdentifier, value.Identifier)
+		}
+		if _, found := identifiers[value.Identifier]; found {
+			return fmt.Errorf("%w: %q", ErrDuplicateIdentifier, value.Identifier)
+		}
+		identifiers[value.Identifier] = struct{}{}
+
+		name := e.Naming.name(value)
+		if name == "" {
+			return fmt.Errorf("%w: %q", ErrEmptyName, value.Identifier)
+		}
+		if _, found := names[name]; found {
+			return fmt.Errorf("%w: %q", ErrDuplicateName, name)
+		}
+		names[name] = value.Identifier
+	}
+
+	for _, value := range e.Values {
+		for _, alias := range value.Aliases {
+			if alias == "" {
+				return fmt.Errorf("%w: %q", ErrEmptyAlias, value.Identifier)
+			}
+			if _, found := names[alias]; found {
+				return fmt.Errorf("%w: %q", ErrDuplicateAlias, alias)
+			}
+			names[alias] = value.Identifier
+		}
+	}
+
+	return e.validateNormalizedNames(names)
+}
+
+// validateCodes checks that the codes are declared for all the values or none of them, and are unique.
+// Marshalling as code (numeric binary included) requires the codes, binary codes can't be negative.
+func (e Enum) validateCodes() error {
+	binaryNumeric := e.Marshalling.BinaryOptions.Generate && e.Marshalling.BinaryOptions.Numeric
+	if !e.hasCodes() {
+		if (e.Marshalling.JSONOptions.Generate && e.Marshalling.JSONOptions.AsCode) ||
+			(e.Marshalling.SQLOptions.Generate && e.Marshalling.SQLOptions.AsCode) ||
+			binaryNumeric {
+			return ErrCodesForMarshallingNotFound
+		}
+		return nil
+	}
+
+	codes := make(map[int]struct{}, len(e.Values))
+	for _, value := range e.Values {
+		if value.Code == nil {
+			return fmt.Errorf("%w: %q", ErrMissingCode, value.Identifier)
+		}
+		if _, found := codes[*value.Code]; found {
+			return fmt.Errorf("%w: %d", ErrDuplicateCode, *value.Code)
+		}
+		if binaryNumeric && *value.Code < 0 {
+			return fmt.Errorf("%w: %d", ErrNegativeBinaryCode, *value.Code)
+		}
+		codes[*value.Code] = struct{}{}
+	}
+	return nil
+}
+
+// validateAttributes checks the attribute declarations and that every value
+// declares a value of the matching type for every attribute, and nothing else.
+func (e Enum) validateAttributes() error {
+	declared := make(map[string]struct{}, len(e.Attributes))
+	methods := make(map[string]struct{}, len(e.Attributes))
+	for _, attribute := range e.Attributes {
+		if !attribute.validName() {
+			return fmt.Errorf("%w: %q", ErrInvalidAttributeName, attribute.Name)
+		}
+		if _, reserved := reservedAttributeMethods[attribute.method()]; reserved {
+			return fmt.Errorf("%w: %q", ErrReservedAttributeName, attribute.Name)
+		}
+		if e.reservedAttributeField(attribute.field()) {
+			return fmt.Errorf("%w: %q", ErrReservedAttributeName, attribute.Name)
+		}
+		if _, found := methods[attribute.method()]; found {
+			return fmt.Errorf("%w: %q", ErrDuplicateAttributeName, attribute.Name)
+		}
+		methods[attribute.method()] = struct{}{}
+		declared[attribute.Name] = struct{}{}
+		if !attribute.Type.valid() {
+			return fmt.Errorf("%w: %q", ErrUnknownAttributeType, attribute.Type)
+		}
+	}
+
+	for _, value := range e.Values {
+		for _, attribute := range e.Attributes {
+			attributeValue, found := value.Attributes[attribute.Name]
+			if !found {
+				return fmt.Errorf("%w: %s.%s", ErrMissingAttributeValue, value.Identifier, attribute.Name)
 			}
+			if _, ok := attribute.Type.literal(attributeValue); !ok {
+				return fmt.Errorf("%w: %s.%s is not %s", ErrInvalidAttributeValue,
+					value.Identifier, attribute.Name, attribute.Type)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(value.Attributes)) {
+			if _, found := declared[name]; !found {
+				return fmt.Errorf("%w: %s.%s", ErrUnknownAttribute, value.Identifier, name)
+			}
+		}
+	}
+	return nil
+}
+
+// reservedAttributeField reports whether the attribute field collides with a generated base struct field
+// or with the unexported sealed method.
+func (e Enum) reservedAttributeField(field string) bool {
+	if _, reserved := reservedAttributeFields[field]; reserved {
+		return true
+	}
+	return field == "sealed"+e.Type
+}
+
+// validateGraphQLNames checks that the GraphQL enum values derived from value identifiers
+// are valid and unique.
+func (e Enum) validateGraphQLNames() error {
+	if !e.Marshalling.GraphQLOptions.Generate {
+		return nil
+	}
+
+	graphQLNames := make(map[string]struct{}, len(e.Values))
+	for _, value := range e.Values {
+		name := graphQLName(value.Identifier)
+		if !isGraphQLName(name) {
+			return fmt.Errorf("%w: %q", ErrInvalidGraphQLName, name)
+		}
+		if _, found := graphQLNames[name]; found {
+			return fmt.Errorf("%w: %q", ErrDuplicateGraphQLName, name)
+		}
+		graphQLNames[name] = struct{}{}
+	}
+	return nil
+}
+
+// validatePreserveUnknown checks that unknown values are not marshalled by the value code or GraphQL name,
+// neither of which can represent an unknown name.
+func (e Enum) validatePreserveUnknown() error {
+	if !e.PreserveUnknown {
+		return nil
+	}
+
+	o := e.Marshalling
+	if (o.JSONOptions.Generate && o.JSONOptions.AsCode) ||
+		(o.SQLOptions.Generate && o.SQLOptions.AsCode) ||
+		(o.BinaryOptions.Generate && o.BinaryOptions.Numeric) ||
+		o.GraphQLOptions.Generate {
+		return ErrUnknownNotPreservable
+	}
+	return nil
+}
+
+// validateSet checks that the value names don't contain the comma separating the set text and SQL elements.
+func (e Enum) validateSet() error {
+	if !e.Set {
+		return nil
+	}
+
+	o := e.Marshalling
+	namesSeparated := o.TextOptions.Generate || (o.SQLOptions.Generate && !o.SQLOptions.AsCode)
+	if !namesSeparated {
+		return nil
+	}
+	for _, value := range e.Values {
+		if name := e.Naming.name(value); strings.Contains(name, ",") {
+			return fmt.Errorf("%w: %q", ErrSetSeparatorInName, name)
 		}
-		if !found {
-			return ErrUndefinedValueNotFound
+	}
+	return nil
+}
+
+// validateNormalizedNames checks that no two values share a name or an alias after normalization.
+func (e Enum) validateNormalizedNames(names map[string]string) error {
+	if !e.Parsing.enabled() {
+		return nil
+	}
+
+	normalizedNames := make(map[string]string, len(names))
+	for _, name := range slices.Sorted(maps.Keys(names)) {
+		normalizedName := e.Parsing.normalize(name)
+		identifier, found := normalizedNames[normalizedName]
+		if found && identifier != names[name] {
+			return fmt.Errorf("%w: %q", ErrAmbiguousNormalizedName, name)
 		}
+		normalizedNames[normalizedName] = names[name]
 	}
+	return nil
+}
 
-	if e.Marshalling.JSONOptions.Generate &&
-		e.Marshalling.JSONOptions.NilToUndefined &&
-		e.UndefinedValue == "" {
-		return ErrUndefinedValueForUnmarshallingNotFound
+func (e Enum) validateUndefined() error {
+	if e.UndefinedValue != "" && !e.hasValue(e.UndefinedValue) {
+		return ErrUndefinedValueNotFound
+	}
+	if e.DefaultValue != "" && !e.hasValue(e.DefaultValue) {
+		return ErrDefaultValueNotFound
+	}
+
+	for _, targets := range e.Marshalling.unmarshalTargets() {
+		if !targets.generate {
+			continue
+		}
+		if (targets.nilToUndefined || targets.unknownToUndefined) && e.UndefinedValue == "" {
+			return ErrUndefinedValueForUnmarshallingNotFound
+		}
+		if targets.nilToDefault && e.DefaultValue == "" {
+			return ErrDefaultValueForUnmarshallingNotFound
+		}
+		if targets.nilToDefault && targets.nilToUndefined {
+			return ErrAmbiguousNilValue
+		}
 	}
 	return nil
 }
+
+func (e Enum) hasValue(identifier string) bool {
+	return slices.ContainsFunc(e.Values, func(value Value) bool {
+		return value.Identifier == identifier
+	})
+}
+
+// nilValue returns the identifier of the value nil (null or empty) input is unmarshalled to,
+// empty if nil input is unmarshalled to nil enum.
+func (e Enum) nilValue(nilToDefault, nilToUndefined bool) string {
+	switch {
+	case nilToDefault:
+		return e.DefaultValue
+	case nilToUndefined:
+		return e.UndefinedValue
+	default:
+		return ""
+	}
+}
+
+// unknownNameLookup returns the function unknown names are unmarshalled with,
+// empty if unknown names are rejected. Preserving unknown names takes precedence over the undefined value.
+func (e Enum) unknownNameLookup(unknownToUndefined bool) string {
+	switch {
+	case e.PreserveUnknown:
+		return "OfOrUnknown"
+	case unknownToUndefined:
+		return "OfOrUndefined"
+	default:
+		return ""
+	}
+}
@@ -38,3 +38,20 @@ func (e SaveFileError) Error() string {
 func (e SaveFileError) Unwrap() error {
 	return e.cause
 }
+
+type EnumError struct {
+	typeName string
+	cause    error
+}
+
+func newEnumError(typeName string, cause error) EnumError {
+	return EnumError{typeName: typeName, cause: cause}
+}
+
+func (e EnumError) Error() string {
+	return "error generating enum \"" + e.typeName + "\": " + e.cause.Error()
+}
+
+func (e EnumError) Unwrap() error {
+	return e.cause
+}
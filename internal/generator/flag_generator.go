@@ -0,0 +1,190 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// flagGenerator generates the FlagType and SliceFlagType implementing flag.Value
+// and pflag.Value (and pflag.SliceValue for the SliceFlagType), so the enum can be used
+// as a command line flag without writing a wrapper.
+type flagGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newFlagGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *flagGenerator {
+	return &flagGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *flagGenerator) imports() []string {
+	if !g.enum.Flag {
+		return nil
+	}
+	return []string{"strings"}
+}
+
+func (g *flagGenerator) generateFlag() {
+	if !g.enum.Flag {
+		return
+	}
+	g.generateFlagUsage()
+	g.generateFlagStruct()
+	g.generateSliceFlagStruct()
+}
+
+func (g *flagGenerator) generateFlagUsage() {
+	w := g.writer
+	e := g.enum
+	w.Line("// FlagUsage appends all the possible " + e.Type + " values to the flag usage.")
+	w.Line("func FlagUsage(usage string) string {")
+	w.Line("\tvalues := Values()")
+	w.Line("\tnames := make([]string, 0, len(values))")
+	w.Line("\tfor _, value := range values {")
+	w.Line("\t\tnames = append(names, value.String())")
+	w.Line("\t}")
+	w.Line("\treturn usage + \" (one of: \" + strings.Join(names, \", \") + \")\"")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *flagGenerator) generateFlagStruct() {
+	w := g.writer
+	e := g.enum
+	w.Line("// " + e.flagStruct + " is a command line flag holding " + e.Type + ".")
+	w.Line("// It implements flag.Value and pflag.Value.")
+	w.Line("type " + e.flagStruct + " struct {")
+	w.Line("\t" + e.Type + " " + e.Type)
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// New" + e.flagStruct + " creates " + e.flagStruct + " with the default value, which may be nil.")
+	w.Line("func New" + e.flagStruct + "(defaultValue " + e.Type + ") *" + e.flagStruct + " {")
+	w.Line("\treturn &" + e.flagStruct + "{" + e.Type + ": defaultValue}")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (f *" + e.flagStruct + ") String() string {")
+	w.Line("\tif f == nil || f." + e.Type + " == nil {")
+	w.Line("\t\treturn \"\"")
+	w.Line("\t}")
+	w.Line("\treturn f." + e.Type + ".String()")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Set sets the value, names not matching any " + e.Type + " value are rejected with " +
+		e.invalidNameError + ".")
+	w.Line("func (f *" + e.flagStruct + ") Set(name string) error {")
+	w.Line("\tvalue, err := Of(name)")
+	w.Line("\tif err != nil {")
+	w.Line("\t\treturn err")
+	w.Line("\t}")
+	w.Line("\tf." + e.Type + " = value")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Type returns the flag value type name displayed by pflag.")
+	w.Line("func (f *" + e.flagStruct + ") Type() string {")
+	w.Line("\treturn \"" + e.Type + "\"")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *flagGenerator) generateSliceFlagStruct() {
+	w := g.writer
+	e := g.enum
+	w.Line("// " + e.sliceFlagStruct + " is a repeated command line flag collecting " + e.Type + " values.")
+	w.Line("// It implements flag.Value, pflag.Value and pflag.SliceValue.")
+	w.Line("// The first Set replaces the default values, subsequent ones append to them.")
+	w.Line("type " + e.sliceFlagStruct + " struct {")
+	w.Line("\tValues  []" + e.Type)
+	w.Line("\tchanged bool")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// New" + e.sliceFlagStruct + " creates " + e.sliceFlagStruct + " with the default values.")
+	w.Line("func New" + e.sliceFlagStruct + "(defaultValues ..." + e.Type + ") *" + e.sliceFlagStruct + " {")
+	w.Line("\treturn &" + e.sliceFlagStruct + "{Values: defaultValues}")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (f *" + e.sliceFlagStruct + ") String() string {")
+	w.Line("\tif f == nil {")
+	w.Line("\t\treturn \"[]\"")
+	w.Line("\t}")
+	w.Line("\treturn \"[\" + strings.Join(f.GetSlice(), \",\") + \"]\"")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Set appends the value, names not matching any " + e.Type + " value are rejected with " +
+		e.invalidNameError + ".")
+	w.Line("func (f *" + e.sliceFlagStruct + ") Set(name string) error {")
+	w.Line("\tif !f.changed {")
+	w.Line("\t\treturn f.Replace([]string{name})")
+	w.Line("\t}")
+	w.Line("\treturn f.Append(name)")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Type returns the flag value type name displayed by pflag.")
+	w.Line("func (f *" + e.sliceFlagStruct + ") Type() string {")
+	w.Line("\treturn \"" + e.Type + "Slice\"")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (f *" + e.sliceFlagStruct + ") Append(name string) error {")
+	w.Line("\tvalue, err := Of(name)")
+	w.Line("\tif err != nil {")
+	w.Line("\t\treturn err")
+	w.Line("\t}")
+	w.Line("\tf.Values = append(f.Values, value)")
+	w.Line("\tf.changed = true")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Replace replaces all the values, none of them is replaced if any name is invalid.")
+	w.Line("func (f *" + e.sliceFlagStruct + ") Replace(names []string) error {")
+	w.Line("\tvalues := make([]" + e.Type + ", 0, len(names))")
+	w.Line("\tfor _, name := range names {")
+	w.Line("\t\tvalue, err := Of(name)")
+	w.Line("\t\tif err != nil {")
+	w.Line("\t\t\treturn err")
+	w.Line("\t\t}")
+	w.Line("\t\tvalues = append(values, value)")
+	w.Line("\t}")
+	w.Line("\tf.Values = values")
+	w.Line("\tf.changed = true")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// GetSlice returns the value names, nil values are returned as empty names, like " + e.flagStruct +
+		" does.")
+	w.Line("func (f *" + e.sliceFlagStruct + ") GetSlice() []string {")
+	w.Line("\tnames := make([]string, 0, len(f.Values))")
+	w.Line("\tfor _, value := range f.Values {")
+	w.Line("\t\tif value == nil {")
+	w.Line("\t\t\tnames = append(names, \"\")")
+	w.Line("\t\t\tcontinue")
+	w.Line("\t\t}")
+	w.Line("\t\tnames = append(names, value.String())")
+	w.Line("\t}")
+	w.Line("\treturn names")
+	w.Line("}")
+	w.LineBreak()
+}
@@ -0,0 +1,80 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// formatGenerator generates slog.LogValuer and fmt.Formatter implementations,
+// so the enum is logged and formatted consistently as its name.
+type formatGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newFormatGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *formatGenerator {
+	return &formatGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *formatGenerator) imports() []string {
+	return []string{"fmt", "log/slog"}
+}
+
+func (g *formatGenerator) generateFormat() {
+	g.generateLogValue()
+	g.generateFormatter()
+}
+
+func (g *formatGenerator) generateLogValue() {
+	w := g.writer
+	e := g.enum
+	w.Line("// LogValue implements slog.LogValuer logging the enum name.")
+	w.Line("func (b " + e.baseStruct + ") LogValue() slog.Value {")
+	w.Line("\treturn slog.StringValue(b.name)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *formatGenerator) generateFormatter() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Format implements fmt.Formatter.")
+	w.Line("// %v and %s format the name, %q the quoted name, %d the ordinal and %+v the " + e.Type + ".name form.")
+	w.Line("func (b " + e.baseStruct + ") Format(state fmt.State, verb rune) {")
+	w.Line("\tswitch {")
+	w.Line("\tcase verb == 'v' && state.Flag('+'):")
+	w.Line("\t\t_, _ = fmt.Fprint(state, \"" + e.Type + ".\"+b.name)")
+	w.Line("\tcase verb == 'v' || verb == 's' || verb == 'q':")
+	w.Line("\t\t_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.name)")
+	w.Line("\tcase verb == 'd':")
+	w.Line("\t\t_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), b.ordinal)")
+	w.Line("\tdefault:")
+	w.Line("\t\t_, _ = fmt.Fprintf(state, \"%%!%c(" + e.Type + "=%s)\", verb, b.name)")
+	w.Line("\t}")
+	w.Line("}")
+	w.LineBreak()
+}
@@ -26,8 +26,12 @@ package generator
 import (
 	"bufio"
 	"bytes"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 const generatorPackageName = "github.com/tompaz3/go-enumerator"
@@ -50,29 +54,133 @@ func newGenerator(enum Enum) *generator {
 
 type generationEnum struct {
 	Enum
+	values                      []generationValue
 	baseStruct                  string
+	unknownStruct               string
 	marshallableStruct          string
+	nullableStruct              string
+	setStruct                   string
+	flagStruct                  string
+	sliceFlagStruct             string
 	invalidNameError            string
 	invalidNameErrorConstructor string
+	invalidNameErrorSentinel    string
 }
 
 func newGenerationEnum(enum Enum) generationEnum {
+	values := make([]generationValue, 0, len(enum.Values))
+	for _, value := range enum.Values {
+		code := 0
+		if value.Code != nil {
+			code = *value.Code
+		}
+		values = append(values, generationValue{
+			identifier:  value.Identifier,
+			name:        enum.Naming.name(value),
+			aliases:     value.Aliases,
+			code:        code,
+			attributes:  value.Attributes,
+			description: strings.TrimSpace(value.Description),
+			deprecated:  strings.TrimSpace(value.Deprecated),
+		})
+	}
+
 	return generationEnum{
 		Enum:                        enum,
+		values:                      values,
 		baseStruct:                  "base" + enum.Type,
+		unknownStruct:               "unknown" + enum.Type,
 		marshallableStruct:          "Marshallable" + enum.Type,
+		nullableStruct:              "Null" + enum.Type,
+		setStruct:                   enum.Type + "Set",
+		flagStruct:                  enum.Type + "Flag",
+		sliceFlagStruct:             enum.Type + "SliceFlag",
 		invalidNameError:            "Invalid" + enum.Type + "NameError",
 		invalidNameErrorConstructor: "newInvalid" + enum.Type + "NameError",
+		invalidNameErrorSentinel:    "ErrInvalid" + enum.Type,
 	}
 }
 
+// generationValue is a Value with the name resolved using the enum NamingStrategy.
+type generationValue struct {
+	identifier  string
+	name        string
+	aliases     []string
+	code        int
+	attributes  map[string]any
+	description string
+	deprecated  string
+}
+
 func Generate(enum Enum) error {
-	src, srcErr := generateSource(enum)
-	if srcErr != nil {
-		return srcErr
+	if err := generateFile(generateSource, enum, enum.Destination); err != nil {
+		return err
+	}
+
+	if enum.Marshalling.JSONOptions.generateV2() {
+		for _, file := range jsonV2Files {
+			jsonV2Destination := siblingDestination(enum.Destination, file.fileSuffix)
+			if err := generateFile(jsonV2SourceGenerator(file), enum, jsonV2Destination); err != nil {
+				return err
+			}
+		}
+	}
+
+	if enum.Marshalling.GraphQLOptions.Generate {
+		graphQLDestination := siblingDestination(enum.Destination, graphQLFileSuffix)
+		if err := generateFile(generateGraphQLSchema, enum, graphQLDestination); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func generateFile(generateContent func(enum Enum) ([]byte, error), enum Enum, destination *string) error {
+	content, err := generateContent(enum)
+	if err != nil {
+		return err
+	}
+
+	return save(content, destination)
+}
+
+// GenerateAll validates all the enums before generating any of them,
+// so a single invalid entry does not leave the enums partially generated.
+// Enums can't share a package directory, as each of them declares the same package-level helpers.
+func GenerateAll(enums []Enum) error {
+	enumsByDirectory := make(map[string]string, len(enums))
+	for _, enum := range enums {
+		if err := enum.validate(); err != nil {
+			return newEnumError(enum.Type, err)
+		}
+		if err := claimPackageDirectory(enumsByDirectory, enum); err != nil {
+			return newEnumError(enum.Type, err)
+		}
 	}
 
-	return save(src, enum.Destination)
+	for _, enum := range enums {
+		if err := Generate(enum); err != nil {
+			return newEnumError(enum.Type, err)
+		}
+	}
+
+	return nil
+}
+
+// claimPackageDirectory records the enum destination directory, rejecting the directory claimed by another enum.
+// Enums printed to the standard output are not checked.
+func claimPackageDirectory(enumsByDirectory map[string]string, enum Enum) error {
+	if enum.Destination == nil || len(*enum.Destination) == 0 {
+		return nil
+	}
+
+	directory := filepath.Dir(filepath.Clean(*enum.Destination))
+	if claimedBy, claimed := enumsByDirectory[directory]; claimed {
+		return fmt.Errorf("%w: %q is taken by %s", ErrSharedPackageDirectory, directory, claimedBy)
+	}
+	enumsByDirectory[directory] = enum.Type
+	return nil
 }
 
 func save(sourceCode []byte, destination *string) error {
@@ -81,6 +189,10 @@ func save(sourceCode []byte, destination *string) error {
 		return resolveDestErr
 	}
 	defer func() {
+		// standard output is shared by all the generated sources
+		if file == os.Stdout {
+			return
+		}
 		if err := file.Close(); err != nil {
 			panic(err)
 		}
@@ -110,8 +222,24 @@ func generateSource(enum Enum) ([]byte, error) {
 	gen.generateBaseImpl()
 	gen.generateValues()
 	gen.generatePublicValuesFunction()
+	gen.generateActiveValuesFunction()
+	gen.generateDefaultFunction()
+	gen.generateUnknownType()
+	gen.generateOrdinalFunctions()
 	gen.generateOfString()
+	gen.generateOfStrict()
+	gen.generateOfCode()
+	gen.generateMarshallable()
 	gen.generateJSONMarshalling()
+	gen.generateTextMarshalling()
+	gen.generateYAMLMarshalling()
+	gen.generateXMLMarshalling()
+	gen.generateBinaryMarshalling()
+	gen.generateGraphQLMarshalling()
+	gen.generateSQLMarshalling()
+	gen.generateNullable()
+	gen.generateSet()
+	gen.generateFlag()
 	gen.generateInvalidNameError()
 
 	if err := gen.writer.Flush(); err != nil {
@@ -121,6 +249,39 @@ func generateSource(enum Enum) ([]byte, error) {
 	return gen.buf.Bytes(), nil
 }
 
+// jsonV2SourceGenerator generates the encoding/json/v2 methods source, which is saved to a separate file
+// as it requires a build constraint.
+func jsonV2SourceGenerator(file jsonV2File) func(enum Enum) ([]byte, error) {
+	return func(enum Enum) ([]byte, error) {
+		gen := newGenerator(enum)
+		jsonV2Gen := newJSONV2MarshallerGenerator(gen.enum, gen.writer)
+		gen.generateCopyright()
+		jsonV2Gen.generateBuildConstraint(file.buildConstraint)
+		gen.generateHeader()
+		gen.generateImportBlock(jsonV2Gen.imports())
+		jsonV2Gen.generateJSONV2Marshalling()
+
+		if err := gen.writer.Flush(); err != nil {
+			return nil, err
+		}
+
+		return gen.buf.Bytes(), nil
+	}
+}
+
+// generateGraphQLSchema generates the GraphQL enum SDL, which is saved to a separate .graphql file.
+func generateGraphQLSchema(enum Enum) ([]byte, error) {
+	gen := newGenerator(enum)
+	newGraphQLMarshallerGenerator(gen.enum, gen.writer).
+		generateSchema()
+
+	if err := gen.writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	return gen.buf.Bytes(), nil
+}
+
 func (g *generator) generateCopyright() {
 	newCopyrightGenerator(g.enum, g.writer).
 		generateCopyrightClause()
@@ -141,30 +302,99 @@ func (g *generator) generateHeader() {
 }
 
 func (g *generator) generateImports() {
+	g.generateImportBlock(g.imports())
+}
+
+func (g *generator) generateImportBlock(imports []string) {
 	w := g.writer
 
-	if !g.enum.Marshalling.JSONOptions.Generate {
+	if len(imports) == 0 {
 		return
 	}
+	standard := make([]string, 0, len(imports))
+	external := make([]string, 0)
+	for _, importPath := range imports {
+		if isStandardImport(importPath) {
+			standard = append(standard, importPath)
+		} else {
+			external = append(external, importPath)
+		}
+	}
+
 	w.Line("import (")
-	w.Line("\t\"bytes\"")
-	newJSONMarshallerGenerator(g.enum, g.writer).
-		generateImports()
+	for _, importPath := range standard {
+		w.Line("\t\"" + importPath + "\"")
+	}
+	if len(standard) > 0 && len(external) > 0 {
+		w.LineBreak()
+	}
+	for _, importPath := range external {
+		w.Line("\t\"" + importPath + "\"")
+	}
 	w.Line(")")
 	w.LineBreak()
 }
 
+// isStandardImport reports whether the import path belongs to the standard library,
+// i.e. its first path element is not a domain name.
+func isStandardImport(importPath string) bool {
+	first, _, _ := strings.Cut(importPath, "/")
+	return !strings.Contains(first, ".")
+}
+
+// imports collects the imports required by all the generators, sorted and deduplicated.
+func (g *generator) imports() []string {
+	imports := make([]string, 0)
+	imports = append(imports, newFormatGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newOrdinalGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newCodeGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newDeprecationGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newOfStringGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newJSONMarshallerGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newTextMarshallerGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newYAMLMarshallerGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newXMLMarshallerGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newBinaryMarshallerGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newGraphQLMarshallerGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newSQLMarshallerGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newSetGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newFlagGenerator(g.enum, g.writer).imports()...)
+	imports = append(imports, newInvalidNameErrorGenerator(g.enum, g.writer).imports()...)
+
+	slices.Sort(imports)
+	return slices.Compact(imports)
+}
+
 func (g *generator) generateInterface() {
 	w := g.writer
 	e := g.enum
 
+	newDescriptionGenerator(g.enum, g.writer).
+		generateTypeDoc()
 	if e.CheckSumType {
+		if strings.TrimSpace(e.Description) != "" {
+			w.Line("//")
+		}
 		w.Line("//sumtype:decl")
 	}
 
 	w.Line("type " + e.Type + " interface {")
 	w.Line("\tsealed" + e.Type + "()")
 	w.Line("\tString() string")
+	newOrdinalGenerator(g.enum, g.writer).
+		generateOrdinalDeclaration()
+	newCodeGenerator(g.enum, g.writer).
+		generateCodeDeclaration()
+	newAttributeGenerator(g.enum, g.writer).
+		generateAttributeDeclarations()
+	newDescriptionGenerator(g.enum, g.writer).
+		generateDescriptionDeclaration()
+	newDeprecationGenerator(g.enum, g.writer).
+		generateIsDeprecatedDeclaration()
+	newUnknownGenerator(g.enum, g.writer).
+		generateIsKnownDeclaration()
+	newMarshallableGenerator(g.enum, g.writer).
+		generateToMarshallableDeclaration()
 	newJSONMarshallerGenerator(g.enum, g.writer).
 		generateToMarshallerDeclaration()
 	w.Line("}")
@@ -175,7 +405,16 @@ func (g *generator) generateBaseImpl() {
 	w := g.writer
 	e := g.enum
 	w.Line("type " + e.baseStruct + " struct {")
-	w.Line("\tname string")
+	w.Line("\tname    string")
+	w.Line("\tordinal int")
+	newCodeGenerator(g.enum, g.writer).
+		generateCodeField()
+	newAttributeGenerator(g.enum, g.writer).
+		generateAttributeFields()
+	newDescriptionGenerator(g.enum, g.writer).
+		generateDescriptionField()
+	newDeprecationGenerator(g.enum, g.writer).
+		generateDeprecatedField()
 	w.Line("}")
 	w.LineBreak()
 	w.Line("func (b " + e.baseStruct + ") sealed" + e.Type + "() {}")
@@ -184,6 +423,20 @@ func (g *generator) generateBaseImpl() {
 	w.Line("\treturn b.name")
 	w.Line("}")
 	w.LineBreak()
+	newOrdinalGenerator(g.enum, g.writer).
+		generateOrdinalMethod()
+	newCodeGenerator(g.enum, g.writer).
+		generateCodeMethod()
+	newAttributeGenerator(g.enum, g.writer).
+		generateAttributeMethods()
+	newDescriptionGenerator(g.enum, g.writer).
+		generateDescriptionMethod()
+	newDeprecationGenerator(g.enum, g.writer).
+		generateIsDeprecatedMethod()
+	newUnknownGenerator(g.enum, g.writer).
+		generateIsKnownMethod()
+	newFormatGenerator(g.enum, g.writer).
+		generateFormat()
 }
 
 func (g *generator) generateValues() {
@@ -191,17 +444,47 @@ func (g *generator) generateValues() {
 	e := g.enum
 	w.Line("var (")
 
-	for _, value := range e.Values {
-		w.Line("\t" + value + " = " + e.baseStruct + "{name: \"" + value + "\"}")
+	codeGen := newCodeGenerator(g.enum, g.writer)
+	attributeGen := newAttributeGenerator(g.enum, g.writer)
+	descriptionGen := newDescriptionGenerator(g.enum, g.writer)
+	deprecationGen := newDeprecationGenerator(g.enum, g.writer)
+	for ordinal, value := range e.values {
+		descriptionGen.generateValueDoc(value)
+		w.Line("\t" + value.identifier + " = " + e.baseStruct + "{name: " + strconv.Quote(value.name) +
+			", ordinal: " + strconv.Itoa(ordinal) + codeGen.valueLiteralField(value) +
+			attributeGen.valueLiteralFields(value) + descriptionGen.valueLiteralField(value) +
+			deprecationGen.valueLiteralField(value) + "}")
 	}
 
 	w.LineBreak()
 	w.Line("\tallValuesByString = map[string]" + e.Type + "{")
 
-	for _, value := range e.Values {
-		w.Line("\t\t" + value + ".String(): " + value + ",")
+	for _, value := range e.values {
+		w.Line("\t\t" + value.identifier + ".String(): " + value.identifier + ",")
+		for _, alias := range value.aliases {
+			w.Line("\t\t" + strconv.Quote(alias) + ": " + value.identifier + ",")
+		}
 	}
 	w.Line("\t}")
+
+	if e.Parsing.enabled() {
+		w.LineBreak()
+		w.Line("\tallValuesByNormalizedString = map[string]" + e.Type + "{")
+		for _, value := range e.values {
+			w.Line("\t\tnormalizeName(" + value.identifier + ".String()): " + value.identifier + ",")
+			for _, alias := range value.aliases {
+				w.Line("\t\tnormalizeName(" + strconv.Quote(alias) + "): " + value.identifier + ",")
+			}
+		}
+		w.Line("\t}")
+	}
+	newOrdinalGenerator(g.enum, g.writer).
+		generateValuesByOrdinal()
+	codeGen.generateValuesByCode()
+	newBinaryMarshallerGenerator(g.enum, g.writer).
+		generateBinaryCodes()
+	newGraphQLMarshallerGenerator(g.enum, g.writer).
+		generateGraphQLNames()
 	w.Line(")")
 	w.LineBreak()
 }
@@ -213,19 +496,107 @@ func (g *generator) generatePublicValuesFunction() {
 	w.Line("// IMPORTANT: Generates a new slice every time to avoid overwriting enum values")
 	w.Line("func Values() []" + e.Type + " {")
 	w.Line("\treturn []" + e.Type + "{")
-	for _, value := range e.Values {
-		w.Line("\t\t" + value + ",")
+	for _, value := range e.values {
+		w.Line("\t\t" + value.identifier + ",")
 	}
 	w.Line("\t}")
 	w.Line("}")
 	w.LineBreak()
 }
 
+func (g *generator) generateDefaultFunction() {
+	w := g.writer
+	e := g.enum
+	if e.DefaultValue == "" {
+		return
+	}
+	w.Line("// Default returns the default value of " + e.Type + ".")
+	w.Line("func Default() " + e.Type + " {")
+	w.Line("\treturn " + e.DefaultValue)
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *generator) generateUnknownType() {
+	newUnknownGenerator(g.enum, g.writer).
+		generateUnknownType()
+}
+
+func (g *generator) generateActiveValuesFunction() {
+	newDeprecationGenerator(g.enum, g.writer).
+		generateActiveValues()
+}
+
+func (g *generator) generateOrdinalFunctions() {
+	newOrdinalGenerator(g.enum, g.writer).
+		generateOrdinalFunctions()
+}
+
 func (g *generator) generateOfString() {
 	newOfStringGenerator(g.enum, g.writer).
 		generateOfStringMethods()
 }
 
+func (g *generator) generateOfStrict() {
+	newDeprecationGenerator(g.enum, g.writer).
+		generateOfStrict()
+}
+
+func (g *generator) generateOfCode() {
+	newCodeGenerator(g.enum, g.writer).
+		generateOfCode()
+}
+
+func (g *generator) generateMarshallable() {
+	newMarshallableGenerator(g.enum, g.writer).
+		generateMarshallable()
+}
+
+func (g *generator) generateTextMarshalling() {
+	newTextMarshallerGenerator(g.enum, g.writer).
+		generateTextMarshalling()
+}
+
+func (g *generator) generateYAMLMarshalling() {
+	newYAMLMarshallerGenerator(g.enum, g.writer).
+		generateYAMLMarshalling()
+}
+
+func (g *generator) generateXMLMarshalling() {
+	newXMLMarshallerGenerator(g.enum, g.writer).
+		generateXMLMarshalling()
+}
+
+func (g *generator) generateBinaryMarshalling() {
+	newBinaryMarshallerGenerator(g.enum, g.writer).
+		generateBinaryMarshalling()
+}
+
+func (g *generator) generateGraphQLMarshalling() {
+	newGraphQLMarshallerGenerator(g.enum, g.writer).
+		generateGraphQLMarshalling()
+}
+
+func (g *generator) generateSQLMarshalling() {
+	newSQLMarshallerGenerator(g.enum, g.writer).
+		generateSQLMarshalling()
+}
+
+func (g *generator) generateNullable() {
+	newNullableGenerator(g.enum, g.writer).
+		generateNullable()
+}
+
+func (g *generator) generateSet() {
+	newSetGenerator(g.enum, g.writer).
+		generateSet()
+}
+
+func (g *generator) generateFlag() {
+	newFlagGenerator(g.enum, g.writer).
+		generateFlag()
+}
+
 func (g *generator) generateJSONMarshalling() {
 	newJSONMarshallerGenerator(g.enum, g.writer).
 		generateJSONMarshalling()
@@ -236,6 +607,17 @@ func (g *generator) generateInvalidNameError() {
 		generateInvalidNameError()
 }
 
+// siblingDestination replaces the destination file extension with the suffix.
+// Sibling of the standard output destination is the standard output as well.
+func siblingDestination(destination *string, suffix string) *string {
+	if destination == nil || len(*destination) == 0 {
+		return destination
+	}
+
+	sibling := strings.TrimSuffix(*destination, filepath.Ext(*destination)) + suffix
+	return &sibling
+}
+
 func resolveDestination(destination *string) (*os.File, error) {
 	if destination == nil || len(*destination) == 0 {
 		return os.Stdout, nil
@@ -25,6 +25,8 @@ package generator_test
 
 import (
 	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -54,6 +56,96 @@ var expectedColorWithUndefinedAndJSONMarshallingNilToUndefined []byte
 //go:embed colorwithoutcopyright/expected_color.txt
 var expectedColorWithoutCopyrightClause []byte
 
+//go:embed colorwithnames/expected_color.txt
+var expectedColorWithNames []byte
+
+//go:embed colorwithlenientparsing/expected_color.txt
+var expectedColorWithLenientParsing []byte
+
+//go:embed colorwithaliases/expected_color.txt
+var expectedColorWithAliases []byte
+
+//go:embed colorwithtextmarshalling/expected_color.txt
+var expectedColorWithTextMarshalling []byte
+
+//go:embed colorwithundefinedandtextmarshallingniltoundefined/expected_color.txt
+var expectedColorWithUndefinedAndTextMarshallingNilToUndefined []byte
+
+//go:embed colorwithyamlmarshalling/expected_color.txt
+var expectedColorWithYAMLMarshalling []byte
+
+//go:embed colorwithundefinedandyamlmarshallingniltoundefined/expected_color.txt
+var expectedColorWithUndefinedAndYAMLMarshallingNilToUndefined []byte
+
+//go:embed colorwithxmlmarshalling/expected_color.txt
+var expectedColorWithXMLMarshalling []byte
+
+//go:embed colorwithundefinedandxmlmarshallingniltoundefined/expected_color.txt
+var expectedColorWithUndefinedAndXMLMarshallingNilToUndefined []byte
+
+//go:embed colorwithbinarymarshalling/expected_color.txt
+var expectedColorWithBinaryMarshalling []byte
+
+//go:embed colorwithnumericbinarymarshalling/expected_color.txt
+var expectedColorWithNumericBinaryMarshalling []byte
+
+//go:embed colorwithsqlmarshalling/expected_color.txt
+var expectedColorWithSQLMarshalling []byte
+
+//go:embed colorwithundefinedandsqlmarshallingnulltoundefined/expected_color.txt
+var expectedColorWithUndefinedAndSQLMarshallingNullToUndefined []byte
+
+//go:embed colorwithnullable/expected_color.txt
+var expectedColorWithNullable []byte
+
+//go:embed colorwithflag/expected_color.txt
+var expectedColorWithFlag []byte
+
+//go:embed colorwithattributes/expected_color.txt
+var expectedColorWithAttributes []byte
+
+//go:embed colorwithdescriptions/expected_color.txt
+var expectedColorWithDescriptions []byte
+
+//go:embed colorwithdeprecations/expected_color.txt
+var expectedColorWithDeprecations []byte
+
+//go:embed colorwithdefault/expected_color.txt
+var expectedColorWithDefault []byte
+
+//go:embed colorwithunknown/expected_color.txt
+var expectedColorWithUnknown []byte
+
+//go:embed colorwithset/expected_color.txt
+var expectedColorWithSet []byte
+
+//go:embed colorwithlargeset/expected_color.txt
+var expectedColorWithLargeSet []byte
+
+//go:embed colorwithcodes/expected_color.txt
+var expectedColorWithCodes []byte
+
+//go:embed colorwithcodes/expected_color_jsonv2.txt
+var expectedColorWithCodesJSONV2Methods []byte
+
+//go:embed colorwithcodes/expected_color_jsonv2_go127.txt
+var expectedColorWithCodesJSONV2MethodsGo127 []byte
+
+//go:embed colorwithgraphql/expected_color.txt
+var expectedColorWithGraphQL []byte
+
+//go:embed colorwithgraphql/expected_color_graphql.txt
+var expectedColorWithGraphQLSchema []byte
+
+//go:embed colorwithjsonv2/expected_color.txt
+var expectedColorWithJSONV2 []byte
+
+//go:embed colorwithjsonv2/expected_color_jsonv2.txt
+var expectedColorWithJSONV2Methods []byte
+
+//go:embed colorwithjsonv2/expected_color_jsonv2_go127.txt
+var expectedColorWithJSONV2MethodsGo127 []byte
+
 //nolint:funlen // this test prepares a few test cases, which tend to be lengthy
 func Test_Generator(t *testing.T) {
 	t.Parallel()
@@ -72,7 +164,7 @@ func Test_Generator(t *testing.T) {
 					CopyrightFile: licenseFilePath,
 					Package:       "color",
 					Type:          "Color",
-					Values:        []string{"Red", "Green", "Blue"},
+					Values:        values("Red", "Green", "Blue"),
 					CheckSumType:  true,
 				}
 			},
@@ -87,7 +179,7 @@ func Test_Generator(t *testing.T) {
 					CopyrightFile:  licenseFilePath,
 					Package:        "color",
 					Type:           "Color",
-					Values:         []string{"Undefined", "Red", "Green", "Blue"},
+					Values:         values("Undefined", "Red", "Green", "Blue"),
 					UndefinedValue: "Undefined",
 				}
 			},
@@ -102,7 +194,7 @@ func Test_Generator(t *testing.T) {
 					CopyrightFile: licenseFilePath,
 					Package:       "color",
 					Type:          "Color",
-					Values:        []string{"Red", "Green", "Blue"},
+					Values:        values("Red", "Green", "Blue"),
 					Marshalling: generator.MarshalOptions{
 						JSONOptions: generator.JSONMarshalOptions{
 							Generate: true,
@@ -121,7 +213,7 @@ func Test_Generator(t *testing.T) {
 					CopyrightFile:  licenseFilePath,
 					Package:        "color",
 					Type:           "Color",
-					Values:         []string{"Undefined", "Red", "Green", "Blue"},
+					Values:         values("Undefined", "Red", "Green", "Blue"),
 					UndefinedValue: "Undefined",
 					Marshalling: generator.MarshalOptions{
 						JSONOptions: generator.JSONMarshalOptions{
@@ -141,7 +233,7 @@ func Test_Generator(t *testing.T) {
 					CopyrightFile:  licenseFilePath,
 					Package:        "color",
 					Type:           "Color",
-					Values:         []string{"Undefined", "Red", "Green", "Blue"},
+					Values:         values("Undefined", "Red", "Green", "Blue"),
 					UndefinedValue: "Undefined",
 					Marshalling: generator.MarshalOptions{
 						JSONOptions: generator.JSONMarshalOptions{
@@ -161,7 +253,7 @@ func Test_Generator(t *testing.T) {
 					Destination:    &destination,
 					Package:        "color",
 					Type:           "Color",
-					Values:         []string{"Undefined", "Red", "Green", "Blue"},
+					Values:         values("Undefined", "Red", "Green", "Blue"),
 					UndefinedValue: "Undefined",
 					Marshalling: generator.MarshalOptions{
 						JSONOptions: generator.JSONMarshalOptions{
@@ -173,23 +265,1546 @@ func Test_Generator(t *testing.T) {
 			},
 			expected: expectedColorWithoutCopyrightClause,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			// given
-			enum := tt.enum()
-
-			// when
-			err := generator.Generate(enum)
-
-			// then
-			assert.NoError(t, err)
-			// and
-			content, err := os.ReadFile(*enum.Destination)
-			assert.NoError(t, err)
-			assert.Equal(t, tt.expected, content)
+		{
+			name: `generate with names`,
+			enum: func() generator.Enum {
+				destination := "./colorwithnames/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values("Red", "DarkGreen", "LightBlue=Light Blue", "Azure=青"),
+					Naming:        generator.NamingKebabCase,
+					Marshalling: generator.MarshalOptions{
+						JSONOptions: generator.JSONMarshalOptions{
+							Generate: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithNames,
+		},
+		{
+			name: `generate with lenient parsing`,
+			enum: func() generator.Enum {
+				destination := "./colorwithlenientparsing/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Undefined", "Red", "DarkGreen=Dark Green", "LightBlue=light-blue"),
+					UndefinedValue: "Undefined",
+					Parsing: generator.ParseOptions{
+						IgnoreCase:       true,
+						TrimSpace:        true,
+						IgnoreSeparators: true,
+					},
+					Marshalling: generator.MarshalOptions{
+						JSONOptions: generator.JSONMarshalOptions{
+							Generate: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithLenientParsing,
+		},
+		{
+			name: `generate with aliases`,
+			enum: func() generator.Enum {
+				destination := "./colorwithaliases/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values: []generator.Value{
+						{Identifier: "Undefined"},
+						{Identifier: "Red", Aliases: []string{"Crimson", "Scarlet"}},
+						{Identifier: "Green"},
+						{Identifier: "Blue", Aliases: []string{"Navy"}},
+					},
+					UndefinedValue: "Undefined",
+					Marshalling: generator.MarshalOptions{
+						JSONOptions: generator.JSONMarshalOptions{
+							Generate:       true,
+							NilToUndefined: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithAliases,
+		},
+		{
+			name: `generate with text marshalling`,
+			enum: func() generator.Enum {
+				destination := "./colorwithtextmarshalling/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values("Red", "Green", "Blue"),
+					Marshalling: generator.MarshalOptions{
+						TextOptions: generator.TextMarshalOptions{
+							Generate: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithTextMarshalling,
+		},
+		{
+			name: `generate with undefined and text marshalling and nil to undefined`,
+			enum: func() generator.Enum {
+				destination := "./colorwithundefinedandtextmarshallingniltoundefined/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Undefined", "Red", "Green", "Blue"),
+					UndefinedValue: "Undefined",
+					Marshalling: generator.MarshalOptions{
+						TextOptions: generator.TextMarshalOptions{
+							Generate:       true,
+							NilToUndefined: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithUndefinedAndTextMarshallingNilToUndefined,
+		},
+		{
+			name: `generate with YAML marshalling`,
+			enum: func() generator.Enum {
+				destination := "./colorwithyamlmarshalling/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values("Red", "Green", "Blue"),
+					Marshalling: generator.MarshalOptions{
+						YAMLOptions: generator.YAMLMarshalOptions{
+							Generate: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithYAMLMarshalling,
+		},
+		{
+			name: `generate with undefined and YAML marshalling and nil to undefined`,
+			enum: func() generator.Enum {
+				destination := "./colorwithundefinedandyamlmarshallingniltoundefined/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Undefined", "Red", "Green", "Blue"),
+					UndefinedValue: "Undefined",
+					Marshalling: generator.MarshalOptions{
+						YAMLOptions: generator.YAMLMarshalOptions{
+							Generate:       true,
+							NilToUndefined: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithUndefinedAndYAMLMarshallingNilToUndefined,
+		},
+		{
+			name: `generate with XML marshalling`,
+			enum: func() generator.Enum {
+				destination := "./colorwithxmlmarshalling/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values("Red", "Green", "Blue"),
+					Marshalling: generator.MarshalOptions{
+						XMLOptions: generator.XMLMarshalOptions{
+							Generate: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithXMLMarshalling,
+		},
+		{
+			name: `generate with undefined and XML marshalling and nil to undefined`,
+			enum: func() generator.Enum {
+				destination := "./colorwithundefinedandxmlmarshallingniltoundefined/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Undefined", "Red", "Green", "Blue"),
+					UndefinedValue: "Undefined",
+					Marshalling: generator.MarshalOptions{
+						XMLOptions: generator.XMLMarshalOptions{
+							Generate:       true,
+							NilToUndefined: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithUndefinedAndXMLMarshallingNilToUndefined,
+		},
+		{
+			name: `generate with binary marshalling`,
+			enum: func() generator.Enum {
+				destination := "./colorwithbinarymarshalling/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values("Red", "Green", "Blue"),
+					Marshalling: generator.MarshalOptions{
+						BinaryOptions: generator.BinaryMarshalOptions{
+							Generate: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithBinaryMarshalling,
+		},
+		{
+			name: `generate with numeric binary marshalling`,
+			enum: func() generator.Enum {
+				destination := "./colorwithnumericbinarymarshalling/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values: []generator.Value{
+						{Identifier: "Red", Code: code(1)},
+						{Identifier: "Green", Code: code(2)},
+						{Identifier: "Blue", Code: code(3)},
+					},
+					Marshalling: generator.MarshalOptions{
+						BinaryOptions: generator.BinaryMarshalOptions{
+							Generate: true,
+							Numeric:  true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithNumericBinaryMarshalling,
+		},
+		{
+			name: `generate with SQL marshalling`,
+			enum: func() generator.Enum {
+				destination := "./colorwithsqlmarshalling/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values("Red", "Green", "Blue"),
+					Marshalling: generator.MarshalOptions{
+						SQLOptions: generator.SQLMarshalOptions{
+							Generate: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithSQLMarshalling,
+		},
+		{
+			name: `generate with undefined and SQL marshalling and null to undefined`,
+			enum: func() generator.Enum {
+				destination := "./colorwithundefinedandsqlmarshallingnulltoundefined/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Undefined", "Red", "Green", "Blue"),
+					UndefinedValue: "Undefined",
+					Marshalling: generator.MarshalOptions{
+						SQLOptions: generator.SQLMarshalOptions{
+							Generate:        true,
+							NullToUndefined: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithUndefinedAndSQLMarshallingNullToUndefined,
+		},
+		{
+			name: `generate with nullable`,
+			enum: func() generator.Enum {
+				destination := "./colorwithnullable/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Undefined", "Red", "Green", "Blue"),
+					UndefinedValue: "Undefined",
+					Marshalling: generator.MarshalOptions{
+						JSONOptions: generator.JSONMarshalOptions{
+							Generate:       true,
+							NilToUndefined: true,
+						},
+						TextOptions: generator.TextMarshalOptions{
+							Generate: true,
+						},
+						YAMLOptions: generator.YAMLMarshalOptions{
+							Generate: true,
+						},
+						XMLOptions: generator.XMLMarshalOptions{
+							Generate: true,
+						},
+						BinaryOptions: generator.BinaryMarshalOptions{
+							Generate: true,
+						},
+						SQLOptions: generator.SQLMarshalOptions{
+							Generate: true,
+						},
+					},
+					Nullable: true,
+				}
+			},
+			expected: expectedColorWithNullable,
+		},
+		{
+			name: `generate with flag`,
+			enum: func() generator.Enum {
+				destination := "./colorwithflag/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values("Red", "Green", "Blue"),
+					Flag:          true,
+				}
+			},
+			expected: expectedColorWithFlag,
+		},
+		{
+			name: `generate with attributes`,
+			enum: func() generator.Enum {
+				destination := "./colorwithattributes/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values: []generator.Value{
+						{
+							Identifier: "Red",
+							Attributes: map[string]any{"hex": "#FF0000", "weight": 3, "primary": true, "luminance": 0.2126},
+						},
+						{
+							Identifier: "Green",
+							Attributes: map[string]any{"hex": "#00FF00", "weight": 2, "primary": true, "luminance": 0.7152},
+						},
+						{
+							Identifier: "Cyan",
+							Attributes: map[string]any{"hex": "#00FFFF", "weight": 1, "primary": false, "luminance": 0.9278},
+						},
+					},
+					Attributes: []generator.Attribute{
+						{Name: "hex", Type: generator.AttributeTypeString},
+						{Name: "weight", Type: generator.AttributeTypeInt},
+						{Name: "primary", Type: generator.AttributeTypeBool},
+						{Name: "luminance", Type: generator.AttributeTypeFloat},
+					},
+				}
+			},
+			expected: expectedColorWithAttributes,
+		},
+		{
+			name: `generate with descriptions`,
+			enum: func() generator.Enum {
+				destination := "./colorwithdescriptions/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Description:   "Color is a paint color.\n\nColors are mixed in the RGB color model.",
+					Values: []generator.Value{
+						{
+							Identifier:  "Red",
+							Description: "Red is the primary color of fire.\n\nIt was previously called Crimson.\n",
+						},
+						{
+							Identifier:  "Green",
+							Description: "Green is the primary color of grass.",
+						},
+						{
+							Identifier: "Blue",
+						},
+					},
+					CheckSumType: true,
+				}
+			},
+			expected: expectedColorWithDescriptions,
+		},
+		{
+			name: `generate with deprecations`,
+			enum: func() generator.Enum {
+				destination := "./colorwithdeprecations/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values: []generator.Value{
+						{
+							Identifier:  "Red",
+							Aliases:     []string{"Crimson"},
+							Description: "Red is the primary color of fire.",
+							Deprecated:  "Use Scarlet instead.",
+						},
+						{
+							Identifier: "Green",
+						},
+						{
+							Identifier: "Blue",
+							Deprecated: "Use Navy instead.",
+						},
+						{
+							Identifier: "Scarlet",
+						},
+						{
+							Identifier: "Navy",
+						},
+					},
+				}
+			},
+			expected: expectedColorWithDeprecations,
+		},
+		{
+			name: `generate with default`,
+			enum: func() generator.Enum {
+				destination := "./colorwithdefault/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Unknown", "Standard", "Red", "Green"),
+					UndefinedValue: "Unknown",
+					DefaultValue:   "Standard",
+					Marshalling: generator.MarshalOptions{
+						JSONOptions: generator.JSONMarshalOptions{
+							Generate:           true,
+							NilToDefault:       true,
+							UnknownToUndefined: true,
+						},
+						TextOptions: generator.TextMarshalOptions{
+							Generate:           true,
+							NilToDefault:       true,
+							UnknownToUndefined: true,
+						},
+						YAMLOptions: generator.YAMLMarshalOptions{
+							Generate:           true,
+							NilToDefault:       true,
+							UnknownToUndefined: true,
+						},
+						XMLOptions: generator.XMLMarshalOptions{
+							Generate:           true,
+							NilToDefault:       true,
+							UnknownToUndefined: true,
+						},
+						SQLOptions: generator.SQLMarshalOptions{
+							Generate:           true,
+							NullToDefault:      true,
+							UnknownToUndefined: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithDefault,
+		},
+		{
+			name: `generate with unknown values preserved`,
+			enum: func() generator.Enum {
+				destination := "./colorwithunknown/color.go"
+				return generator.Enum{
+					Destination:     &destination,
+					CopyrightFile:   licenseFilePath,
+					Package:         "color",
+					Type:            "Color",
+					Values:          values("Undefined", "Red", "Green", "Blue"),
+					UndefinedValue:  "Undefined",
+					PreserveUnknown: true,
+					Parsing: generator.ParseOptions{
+						IgnoreCase: true,
+					},
+					Marshalling: generator.MarshalOptions{
+						JSONOptions: generator.JSONMarshalOptions{
+							Generate:       true,
+							NilToUndefined: true,
+						},
+						TextOptions: generator.TextMarshalOptions{
+							Generate: true,
+						},
+						YAMLOptions: generator.YAMLMarshalOptions{
+							Generate: true,
+						},
+						XMLOptions: generator.XMLMarshalOptions{
+							Generate: true,
+						},
+						BinaryOptions: generator.BinaryMarshalOptions{
+							Generate: true,
+						},
+						SQLOptions: generator.SQLMarshalOptions{
+							Generate:        true,
+							NullToUndefined: true,
+						},
+					},
+				}
+			},
+			expected: expectedColorWithUnknown,
+		},
+		{
+			name: `generate with set`,
+			enum: func() generator.Enum {
+				destination := "./colorwithset/color.go"
+				return generator.Enum{
+					Destination:    &destination,
+					CopyrightFile:  licenseFilePath,
+					Package:        "color",
+					Type:           "Color",
+					Values:         values("Undefined", "Red", "Green", "Blue"),
+					UndefinedValue: "Undefined",
+					Marshalling: generator.MarshalOptions{
+						JSONOptions: generator.JSONMarshalOptions{
+							Generate: true,
+						},
+						TextOptions: generator.TextMarshalOptions{
+							Generate:           true,
+							UnknownToUndefined: true,
+						},
+						SQLOptions: generator.SQLMarshalOptions{
+							Generate: true,
+						},
+					},
+					Set: true,
+				}
+			},
+			expected: expectedColorWithSet,
+		},
+		{
+			name: `generate with set of more than 64 values`,
+			enum: func() generator.Enum {
+				destination := "./colorwithlargeset/color.go"
+				return generator.Enum{
+					Destination:   &destination,
+					CopyrightFile: licenseFilePath,
+					Package:       "color",
+					Type:          "Color",
+					Values:        values(numberedDefinitions("Shade", 70)...),
+					Set:           true,
+				}
+			},
+			expected: expectedColorWithLargeSet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			enum := tt.enum()
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.NoError(t, err)
+			// and
+			content, err := os.ReadFile(*enum.Destination)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
+}
+
+func Test_Generate_JSONV2(t *testing.T) {
+	t.Parallel()
+
+	// given
+	destination := "./colorwithjsonv2/color.go"
+	enum := generator.Enum{
+		Destination:    &destination,
+		CopyrightFile:  licenseFilePath,
+		Package:        "color",
+		Type:           "Color",
+		Values:         values("Undefined", "Red", "Green", "Blue"),
+		UndefinedValue: "Undefined",
+		Marshalling: generator.MarshalOptions{
+			JSONOptions: generator.JSONMarshalOptions{
+				Generate: true,
+				V2:       true,
+			},
+		},
+		Nullable: true,
+	}
+
+	// when
+	err := generator.Generate(enum)
+
+	// then
+	assert.NoError(t, err)
+	// and
+	content, err := os.ReadFile(destination)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithJSONV2, content)
+	// and
+	jsonV2Content, err := os.ReadFile("./colorwithjsonv2/color_jsonv2.go")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithJSONV2Methods, jsonV2Content)
+	// and
+	jsonV2Go127Content, err := os.ReadFile("./colorwithjsonv2/color_jsonv2_go127.go")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithJSONV2MethodsGo127, jsonV2Go127Content)
+}
+
+func Test_Generate_GraphQL(t *testing.T) {
+	t.Parallel()
+
+	// given
+	destination := "./colorwithgraphql/color.go"
+	enum := generator.Enum{
+		Destination:   &destination,
+		CopyrightFile: licenseFilePath,
+		Package:       "color",
+		Type:          "Color",
+		Values:        values("Red", "Green", "Blue", "LightBlue=light-blue"),
+		Marshalling: generator.MarshalOptions{
+			GraphQLOptions: generator.GraphQLMarshalOptions{
+				Generate: true,
+			},
+		},
+	}
+
+	// when
+	err := generator.Generate(enum)
+
+	// then
+	assert.NoError(t, err)
+	// and
+	content, err := os.ReadFile(destination)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithGraphQL, content)
+	// and
+	schema, err := os.ReadFile("./colorwithgraphql/color.graphql")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithGraphQLSchema, schema)
+}
+
+func Test_Generate_Codes(t *testing.T) {
+	t.Parallel()
+
+	// given
+	destination := "./colorwithcodes/color.go"
+	enum := generator.Enum{
+		Destination:   &destination,
+		CopyrightFile: licenseFilePath,
+		Package:       "color",
+		Type:          "Color",
+		Values: []generator.Value{
+			{Identifier: "Red", Code: code(10)},
+			{Identifier: "Green", Code: code(20)},
+			{Identifier: "Blue", Code: code(5)},
+		},
+		Marshalling: generator.MarshalOptions{
+			JSONOptions: generator.JSONMarshalOptions{
+				Generate: true,
+				V2:       true,
+				AsCode:   true,
+			},
+			BinaryOptions: generator.BinaryMarshalOptions{
+				Generate: true,
+				Numeric:  true,
+			},
+			SQLOptions: generator.SQLMarshalOptions{
+				Generate: true,
+				AsCode:   true,
+			},
+		},
+		Nullable: true,
+		Set:      true,
+	}
+
+	// when
+	err := generator.Generate(enum)
+
+	// then
+	assert.NoError(t, err)
+	// and
+	content, err := os.ReadFile(destination)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithCodes, content)
+	// and
+	jsonV2Content, err := os.ReadFile("./colorwithcodes/color_jsonv2.go")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithCodesJSONV2Methods, jsonV2Content)
+	// and
+	jsonV2Go127Content, err := os.ReadFile("./colorwithcodes/color_jsonv2_go127.go")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedColorWithCodesJSONV2MethodsGo127, jsonV2Go127Content)
+}
+
+func Test_GenerateAll_InvalidEnum(t *testing.T) {
+	t.Parallel()
+
+	// given
+	destination := filepath.Join(t.TempDir(), "color", "color.go")
+	enums := []generator.Enum{
+		{
+			Destination: &destination,
+			Package:     "color",
+			Type:        "Color",
+			Values:      values("Red", "Green", "Blue"),
+		},
+		{
+			Destination: &destination,
+			Package:     "shape",
+			Type:        "Shape",
+			Values:      []generator.Value{},
+		},
+	}
+
+	// when
+	err := generator.GenerateAll(enums)
+
+	// then
+	var enumError generator.EnumError
+	assert.ErrorAs(t, err, &enumError)
+	assert.ErrorIs(t, err, generator.ErrEmptyValues)
+	assert.Equal(t, `error generating enum "Shape": values are empty`, err.Error())
+	// and
+	assert.NoFileExists(t, destination)
+}
+
+func Test_GenerateAll_SharedPackageDirectory(t *testing.T) {
+	t.Parallel()
+
+	// given
+	directory := t.TempDir()
+	colorDestination := filepath.Join(directory, "color.go")
+	shapeDestination := filepath.Join(directory, "shape.go")
+	enums := []generator.Enum{
+		{
+			Destination: &colorDestination,
+			Package:     "color",
+			Type:        "Color",
+			Values:      values("Red", "Green", "Blue"),
+		},
+		{
+			Destination: &shapeDestination,
+			Package:     "color",
+			Type:        "Shape",
+			Values:      values("Circle", "Square"),
+		},
+	}
+
+	// when
+	err := generator.GenerateAll(enums)
+
+	// then
+	var enumError generator.EnumError
+	assert.ErrorAs(t, err, &enumError)
+	assert.ErrorIs(t, err, generator.ErrSharedPackageDirectory)
+	assert.Equal(t,
+		`error generating enum "Shape": enums can't be generated to the same package directory: `+
+			strconv.Quote(directory)+` is taken by Color`,
+		err.Error(),
+	)
+	// and
+	assert.NoFileExists(t, colorDestination)
+	assert.NoFileExists(t, shapeDestination)
+}
+
+//nolint:paralleltest // standard output is replaced for the test duration
+func Test_GenerateAll_StandardOutput(t *testing.T) {
+	// given
+	stdout, err := os.CreateTemp(t.TempDir(), "stdout")
+	assert.NoError(t, err)
+	original := os.Stdout
+	os.Stdout = stdout
+	t.Cleanup(func() {
+		os.Stdout = original
+		_ = stdout.Close()
+	})
+	enums := []generator.Enum{
+		{
+			Package: "color",
+			Type:    "Color",
+			Values:  values("Red", "Green", "Blue"),
+		},
+		{
+			Package: "shape",
+			Type:    "Shape",
+			Values:  values("Circle", "Square"),
+		},
+	}
+
+	// when
+	err = generator.GenerateAll(enums)
+
+	// then
+	assert.NoError(t, err)
+	// and
+	content, err := os.ReadFile(stdout.Name())
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "package color")
+	assert.Contains(t, string(content), "package shape")
+}
+
+func values(definitions ...string) []generator.Value {
+	result := make([]generator.Value, 0, len(definitions))
+	for _, definition := range definitions {
+		result = append(result, generator.NewValue(definition))
+	}
+	return result
+}
+
+func numberedDefinitions(prefix string, count int) []string {
+	definitions := make([]string, 0, count)
+	for i := range count {
+		definitions = append(definitions, prefix+strconv.Itoa(i))
+	}
+	return definitions
+}
+
+func code(value int) *int {
+	return &value
+}
+
+func Test_Generate_InvalidValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		values   []generator.Value
+		naming   generator.NamingStrategy
+		parsing  generator.ParseOptions
+		expected error
+	}{
+		{
+			name:     `GIVEN invalid identifier WHEN Generate THEN error`,
+			values:   values("Light Blue"),
+			expected: generator.ErrInvalidIdentifier,
+		},
+		{
+			name:     `GIVEN duplicated identifier WHEN Generate THEN error`,
+			values:   values("Red", "Red=red"),
+			expected: generator.ErrDuplicateIdentifier,
+		},
+		{
+			name:     `GIVEN duplicated name WHEN Generate THEN error`,
+			values:   values("Red", "Crimson=Red"),
+			expected: generator.ErrDuplicateName,
+		},
+		{
+			name:     `GIVEN duplicated derived name WHEN Generate THEN error`,
+			values:   values("DarkRed", "Dark_Red"),
+			naming:   generator.NamingSnakeCase,
+			expected: generator.ErrDuplicateName,
+		},
+		{
+			name: `GIVEN alias equal to another value name WHEN Generate THEN error`,
+			values: []generator.Value{
+				{Identifier: "Red", Aliases: []string{"Blue"}},
+				{Identifier: "Blue"},
+			},
+			expected: generator.ErrDuplicateAlias,
+		},
+		{
+			name: `GIVEN alias equal to another value alias WHEN Generate THEN error`,
+			values: []generator.Value{
+				{Identifier: "Red", Aliases: []string{"Dark"}},
+				{Identifier: "Blue", Aliases: []string{"Dark"}},
+			},
+			expected: generator.ErrDuplicateAlias,
+		},
+		{
+			name: `GIVEN empty alias WHEN Generate THEN error`,
+			values: []generator.Value{
+				{Identifier: "Red", Aliases: []string{""}},
+			},
+			expected: generator.ErrEmptyAlias,
+		},
+		{
+			name: `GIVEN alias equal to another value name after normalization WHEN Generate THEN error`,
+			values: []generator.Value{
+				{Identifier: "Red", Aliases: []string{"BLUE"}},
+				{Identifier: "Blue"},
+			},
+			parsing:  generator.ParseOptions{IgnoreCase: true},
+			expected: generator.ErrAmbiguousNormalizedName,
+		},
+		{
+			name:     `GIVEN names equal after normalization WHEN Generate THEN error`,
+			values:   values("DarkRed=dark-red", "DarkRed2=Dark Red"),
+			parsing:  generator.ParseOptions{IgnoreCase: true, IgnoreSeparators: true},
+			expected: generator.ErrAmbiguousNormalizedName,
+		},
+		{
+			name:     `GIVEN unknown naming strategy WHEN Generate THEN error`,
+			values:   values("Red"),
+			naming:   generator.NamingStrategy("Train-Case"),
+			expected: generator.ErrUnknownNamingStrategy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "color.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "color",
+				Type:        "Color",
+				Values:      tt.values,
+				Naming:      tt.naming,
+				Parsing:     tt.parsing,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoFileExists(t, destination)
+		})
+	}
+}
+
+func Test_Generate_NamingStrategy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		naming   generator.NamingStrategy
+		expected []string
+	}{
+		{
+			name:     `GIVEN identifier naming WHEN Generate THEN identifiers`,
+			naming:   generator.NamingIdentifier,
+			expected: []string{"InProgress", "HTTPServer", "Done_v2"},
+		},
+		{
+			name:     `GIVEN snake_case naming WHEN Generate THEN snake case names`,
+			naming:   generator.NamingSnakeCase,
+			expected: []string{"in_progress", "http_server", "done_v2"},
+		},
+		{
+			name:     `GIVEN kebab-case naming WHEN Generate THEN kebab case names`,
+			naming:   generator.NamingKebabCase,
+			expected: []string{"in-progress", "http-server", "done-v2"},
+		},
+		{
+			name:     `GIVEN SCREAMING_SNAKE naming WHEN Generate THEN screaming snake case names`,
+			naming:   generator.NamingScreamingSnakeCase,
+			expected: []string{"IN_PROGRESS", "HTTP_SERVER", "DONE_V2"},
+		},
+		{
+			name:     `GIVEN lowerCamel naming WHEN Generate THEN lower camel case names`,
+			naming:   generator.NamingLowerCamelCase,
+			expected: []string{"inProgress", "httpServer", "doneV2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "status.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "status",
+				Type:        "Status",
+				Values:      values("InProgress", "HTTPServer", "Done_v2"),
+				Naming:      tt.naming,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.NoError(t, err)
+			// and
+			content, err := os.ReadFile(destination)
+			assert.NoError(t, err)
+			for _, name := range tt.expected {
+				assert.Contains(t, string(content), `{name: "`+name+`", ordinal: `)
+			}
+		})
+	}
+}
+
+func Test_Generate_InvalidUndefined(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		undefined    string
+		defaultValue string
+		marshalling  generator.MarshalOptions
+		expected     error
+	}{
+		{
+			name:      `GIVEN undefined not in values WHEN Generate THEN error`,
+			undefined: "Purple",
+			expected:  generator.ErrUndefinedValueNotFound,
+		},
+		{
+			name: `GIVEN JSON nil to undefined without undefined WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{Generate: true, NilToUndefined: true},
+			},
+			expected: generator.ErrUndefinedValueForUnmarshallingNotFound,
+		},
+		{
+			name: `GIVEN text nil to undefined without undefined WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				TextOptions: generator.TextMarshalOptions{Generate: true, NilToUndefined: true},
+			},
+			expected: generator.ErrUndefinedValueForUnmarshallingNotFound,
+		},
+		{
+			name: `GIVEN YAML nil to undefined without undefined WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				YAMLOptions: generator.YAMLMarshalOptions{Generate: true, NilToUndefined: true},
+			},
+			expected: generator.ErrUndefinedValueForUnmarshallingNotFound,
+		},
+		{
+			name: `GIVEN XML nil to undefined without undefined WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				XMLOptions: generator.XMLMarshalOptions{Generate: true, NilToUndefined: true},
+			},
+			expected: generator.ErrUndefinedValueForUnmarshallingNotFound,
+		},
+		{
+			name: `GIVEN SQL null to undefined without undefined WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				SQLOptions: generator.SQLMarshalOptions{Generate: true, NullToUndefined: true},
+			},
+			expected: generator.ErrUndefinedValueForUnmarshallingNotFound,
+		},
+		{
+			name: `GIVEN JSON unknown to undefined without undefined WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{Generate: true, UnknownToUndefined: true},
+			},
+			expected: generator.ErrUndefinedValueForUnmarshallingNotFound,
+		},
+		{
+			name: `GIVEN SQL unknown to undefined without undefined WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				SQLOptions: generator.SQLMarshalOptions{Generate: true, UnknownToUndefined: true},
+			},
+			expected: generator.ErrUndefinedValueForUnmarshallingNotFound,
+		},
+		{
+			name:         `GIVEN default not in values WHEN Generate THEN error`,
+			defaultValue: "Purple",
+			expected:     generator.ErrDefaultValueNotFound,
+		},
+		{
+			name: `GIVEN text nil to default without default WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				TextOptions: generator.TextMarshalOptions{Generate: true, NilToDefault: true},
+			},
+			expected: generator.ErrDefaultValueForUnmarshallingNotFound,
+		},
+		{
+			name: `GIVEN SQL null to default without default WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				SQLOptions: generator.SQLMarshalOptions{Generate: true, NullToDefault: true},
+			},
+			expected: generator.ErrDefaultValueForUnmarshallingNotFound,
+		},
+		{
+			name:         `GIVEN YAML nil to both default and undefined WHEN Generate THEN error`,
+			undefined:    "Red",
+			defaultValue: "Green",
+			marshalling: generator.MarshalOptions{
+				YAMLOptions: generator.YAMLMarshalOptions{Generate: true, NilToUndefined: true, NilToDefault: true},
+			},
+			expected: generator.ErrAmbiguousNilValue,
+		},
+		{
+			name:         `GIVEN SQL null to both default and undefined WHEN Generate THEN error`,
+			undefined:    "Red",
+			defaultValue: "Green",
+			marshalling: generator.MarshalOptions{
+				SQLOptions: generator.SQLMarshalOptions{Generate: true, NullToUndefined: true, NullToDefault: true},
+			},
+			expected: generator.ErrAmbiguousNilValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "color.go")
+			enum := generator.Enum{
+				Destination:    &destination,
+				Package:        "color",
+				Type:           "Color",
+				Values:         values("Red", "Green", "Blue"),
+				UndefinedValue: tt.undefined,
+				DefaultValue:   tt.defaultValue,
+				Marshalling:    tt.marshalling,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoFileExists(t, destination)
+		})
+	}
+}
+
+func Test_Generate_InvalidGraphQLNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		values   []generator.Value
+		expected error
+	}{
+		{
+			name:     `GIVEN identifier with non-ASCII letter WHEN Generate THEN error`,
+			values:   values("Red", "Écru"),
+			expected: generator.ErrInvalidGraphQLName,
+		},
+		{
+			name:     `GIVEN identifiers with the same GraphQL name WHEN Generate THEN error`,
+			values:   values("LightBlue", "LIGHT_BLUE"),
+			expected: generator.ErrDuplicateGraphQLName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "color.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "color",
+				Type:        "Color",
+				Values:      tt.values,
+				Marshalling: generator.MarshalOptions{
+					GraphQLOptions: generator.GraphQLMarshalOptions{Generate: true},
+				},
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoFileExists(t, destination)
+		})
+	}
+}
+
+func Test_Generate_InvalidCodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		values      []generator.Value
+		marshalling generator.MarshalOptions
+		expected    error
+	}{
+		{
+			name: `GIVEN code missing for some value WHEN Generate THEN error`,
+			values: []generator.Value{
+				{Identifier: "Red", Code: code(1)},
+				{Identifier: "Green"},
+			},
+			expected: generator.ErrMissingCode,
+		},
+		{
+			name: `GIVEN duplicated code WHEN Generate THEN error`,
+			values: []generator.Value{
+				{Identifier: "Red", Code: code(1)},
+				{Identifier: "Green", Code: code(1)},
+			},
+			expected: generator.ErrDuplicateCode,
+		},
+		{
+			name: `GIVEN negative code and numeric binary WHEN Generate THEN error`,
+			values: []generator.Value{
+				{Identifier: "Red", Code: code(-1)},
+				{Identifier: "Green", Code: code(1)},
+			},
+			marshalling: generator.MarshalOptions{
+				BinaryOptions: generator.BinaryMarshalOptions{Generate: true, Numeric: true},
+			},
+			expected: generator.ErrNegativeBinaryCode,
+		},
+		{
+			name:   `GIVEN numeric binary without codes WHEN Generate THEN error`,
+			values: values("Red", "Green"),
+			marshalling: generator.MarshalOptions{
+				BinaryOptions: generator.BinaryMarshalOptions{Generate: true, Numeric: true},
+			},
+			expected: generator.ErrCodesForMarshallingNotFound,
+		},
+		{
+			name:   `GIVEN JSON as code without codes WHEN Generate THEN error`,
+			values: values("Red", "Green"),
+			marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{Generate: true, AsCode: true},
+			},
+			expected: generator.ErrCodesForMarshallingNotFound,
+		},
+		{
+			name:   `GIVEN SQL as code without codes WHEN Generate THEN error`,
+			values: values("Red", "Green"),
+			marshalling: generator.MarshalOptions{
+				SQLOptions: generator.SQLMarshalOptions{Generate: true, AsCode: true},
+			},
+			expected: generator.ErrCodesForMarshallingNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "color.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "color",
+				Type:        "Color",
+				Values:      tt.values,
+				Marshalling: tt.marshalling,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoFileExists(t, destination)
+		})
+	}
+}
+
+func Test_Generate_InvalidAttributes(t *testing.T) {
+	t.Parallel()
+
+	hex := generator.Attribute{Name: "hex", Type: generator.AttributeTypeString}
+	tests := []struct {
+		name       string
+		attributes []generator.Attribute
+		values     []generator.Value
+		expected   error
+	}{
+		{
+			name:       `GIVEN attribute name not being an identifier WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "hex-code", Type: generator.AttributeTypeString}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"hex-code": "#FF0000"}}},
+			expected:   generator.ErrInvalidAttributeName,
+		},
+		{
+			name:       `GIVEN attribute named after generated method WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "ordinal", Type: generator.AttributeTypeInt}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"ordinal": 1}}},
+			expected:   generator.ErrReservedAttributeName,
+		},
+		{
+			name:       `GIVEN attribute named after generated field WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "deprecated", Type: generator.AttributeTypeBool}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"deprecated": true}}},
+			expected:   generator.ErrReservedAttributeName,
+		},
+		{
+			name:       `GIVEN attribute named after generated sealed method WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "sealedColor", Type: generator.AttributeTypeBool}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"sealedColor": true}}},
+			expected:   generator.ErrReservedAttributeName,
+		},
+		{
+			name:       `GIVEN attributes with the same method name WHEN Generate THEN error`,
+			attributes: []generator.Attribute{hex, {Name: "Hex", Type: generator.AttributeTypeString}},
+			values: []generator.Value{
+				{Identifier: "Red", Attributes: map[string]any{"hex": "#FF0000", "Hex": "#FF0000"}},
+			},
+			expected: generator.ErrDuplicateAttributeName,
+		},
+		{
+			name:       `GIVEN unknown attribute type WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "hex", Type: "bytes"}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"hex": "#FF0000"}}},
+			expected:   generator.ErrUnknownAttributeType,
+		},
+		{
+			name:       `GIVEN attribute missing for some value WHEN Generate THEN error`,
+			attributes: []generator.Attribute{hex},
+			values: []generator.Value{
+				{Identifier: "Red", Attributes: map[string]any{"hex": "#FF0000"}},
+				{Identifier: "Green"},
+			},
+			expected: generator.ErrMissingAttributeValue,
+		},
+		{
+			name:       `GIVEN undeclared value attribute WHEN Generate THEN error`,
+			attributes: []generator.Attribute{hex},
+			values: []generator.Value{
+				{Identifier: "Red", Attributes: map[string]any{"hex": "#FF0000", "weight": 1}},
+			},
+			expected: generator.ErrUnknownAttribute,
+		},
+		{
+			name:       `GIVEN string value for int attribute WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "weight", Type: generator.AttributeTypeInt}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"weight": "1"}}},
+			expected:   generator.ErrInvalidAttributeValue,
+		},
+		{
+			name:       `GIVEN fractional value for int attribute WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "weight", Type: generator.AttributeTypeInt}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"weight": 1.5}}},
+			expected:   generator.ErrInvalidAttributeValue,
+		},
+		{
+			name:       `GIVEN int value for bool attribute WHEN Generate THEN error`,
+			attributes: []generator.Attribute{{Name: "primary", Type: generator.AttributeTypeBool}},
+			values:     []generator.Value{{Identifier: "Red", Attributes: map[string]any{"primary": 1}}},
+			expected:   generator.ErrInvalidAttributeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "color.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "color",
+				Type:        "Color",
+				Values:      tt.values,
+				Attributes:  tt.attributes,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoFileExists(t, destination)
+		})
+	}
+}
+
+func Test_Generate_InvalidPreserveUnknown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		marshalling generator.MarshalOptions
+	}{
+		{
+			name: `GIVEN JSON as code WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{Generate: true, AsCode: true},
+			},
+		},
+		{
+			name: `GIVEN SQL as code WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				SQLOptions: generator.SQLMarshalOptions{Generate: true, AsCode: true},
+			},
+		},
+		{
+			name: `GIVEN numeric binary WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				BinaryOptions: generator.BinaryMarshalOptions{Generate: true, Numeric: true},
+			},
+		},
+		{
+			name: `GIVEN GraphQL WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				GraphQLOptions: generator.GraphQLMarshalOptions{Generate: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "color.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "color",
+				Type:        "Color",
+				Values: []generator.Value{
+					{Identifier: "Red", Code: code(1)},
+					{Identifier: "Green", Code: code(2)},
+				},
+				PreserveUnknown: true,
+				Marshalling:     tt.marshalling,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, generator.ErrUnknownNotPreservable)
+			assert.NoFileExists(t, destination)
+		})
+	}
+}
+
+func Test_Generate_SetWords(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		count    int
+		expected string
+	}{
+		{
+			name:     `GIVEN 64 values WHEN Generate THEN single word bitset`,
+			count:    64,
+			expected: "words [1]uint64",
+		},
+		{
+			name:     `GIVEN 65 values WHEN Generate THEN two words bitset`,
+			count:    65,
+			expected: "words [2]uint64",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "status.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "status",
+				Type:        "Status",
+				Values:      values(numberedDefinitions("Value", tt.count)...),
+				Set:         true,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.NoError(t, err)
+			// and
+			content, err := os.ReadFile(destination)
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), tt.expected)
+		})
+	}
+}
+
+func Test_Generate_InvalidSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		marshalling generator.MarshalOptions
+	}{
+		{
+			name: `GIVEN name with comma and text marshalling WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				TextOptions: generator.TextMarshalOptions{Generate: true},
+			},
+		},
+		{
+			name: `GIVEN name with comma and SQL marshalling WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				SQLOptions: generator.SQLMarshalOptions{Generate: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			destination := filepath.Join(t.TempDir(), "color.go")
+			enum := generator.Enum{
+				Destination: &destination,
+				Package:     "color",
+				Type:        "Color",
+				Values:      values("Red", "Green=green,lime"),
+				Marshalling: tt.marshalling,
+				Set:         true,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, generator.ErrSetSeparatorInName)
+			assert.NoFileExists(t, destination)
+		})
+	}
+}
+
+func Test_Generate_SeparateFileWithoutDestination(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		marshalling generator.MarshalOptions
+	}{
+		{
+			name: `GIVEN JSON v2 and no destination WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{Generate: true, V2: true},
+			},
+		},
+		{
+			name: `GIVEN GraphQL and no destination WHEN Generate THEN error`,
+			marshalling: generator.MarshalOptions{
+				GraphQLOptions: generator.GraphQLMarshalOptions{Generate: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			enum := generator.Enum{
+				Package:     "color",
+				Type:        "Color",
+				Values:      values("Red", "Green"),
+				Marshalling: tt.marshalling,
+			}
+
+			// when
+			err := generator.Generate(enum)
+
+			// then
+			assert.ErrorIs(t, err, generator.ErrSeparateFileWithoutDestination)
 		})
 	}
 }
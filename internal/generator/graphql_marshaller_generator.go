@@ -0,0 +1,158 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import "strconv"
+
+const graphQLFileSuffix = ".graphql"
+
+// graphQLMarshallerGenerator generates gqlgen compatible graphql.Marshaler and graphql.Unmarshaler methods
+// (MarshalGQL and UnmarshalGQL) and the matching GraphQL enum SDL.
+// GraphQL enum values are the screaming snake case value identifiers, e.g. IN_PROGRESS.
+type graphQLMarshallerGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newGraphQLMarshallerGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *graphQLMarshallerGenerator {
+	return &graphQLMarshallerGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+// graphQLName returns the GraphQL enum value name of the value identifier.
+func graphQLName(identifier string) string {
+	return NamingScreamingSnakeCase.name(Value{Identifier: identifier})
+}
+
+// isGraphQLName reports whether the name matches GraphQL Name grammar, i.e. /[_A-Za-z][_0-9A-Za-z]*/.
+func isGraphQLName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', 'A' <= r && r <= 'Z', 'a' <= r && r <= 'z':
+		case '0' <= r && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (g *graphQLMarshallerGenerator) imports() []string {
+	if !g.enum.Marshalling.GraphQLOptions.Generate {
+		return nil
+	}
+	return []string{"fmt", "io", "strconv"}
+}
+
+// generateGraphQLNames generates GraphQL names lookups, to be placed in the values var block.
+func (g *graphQLMarshallerGenerator) generateGraphQLNames() {
+	if !g.enum.Marshalling.GraphQLOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.LineBreak()
+	w.Line("\tgraphQLNamesByValue = map[" + e.Type + "]string{")
+	for _, value := range e.values {
+		w.Line("\t\t" + value.identifier + ": " + strconv.Quote(graphQLName(value.identifier)) + ",")
+	}
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\tvaluesByGraphQLName = map[string]" + e.Type + "{")
+	for _, value := range e.values {
+		w.Line("\t\t" + strconv.Quote(graphQLName(value.identifier)) + ": " + value.identifier + ",")
+	}
+	w.Line("\t}")
+}
+
+func (g *graphQLMarshallerGenerator) generateGraphQLMarshalling() {
+	if !g.enum.Marshalling.GraphQLOptions.Generate {
+		return
+	}
+	g.generateMarshalGQL()
+	g.generateUnmarshalGQL()
+}
+
+func (g *graphQLMarshallerGenerator) generateMarshalGQL() {
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalGQL implements gqlgen graphql.Marshaler writing the GraphQL enum value,")
+	w.Line("// nil enum is written as null.")
+	w.Line("func (m " + e.marshallableStruct + ") MarshalGQL(writer io.Writer) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\t_, _ = io.WriteString(writer, \"null\")")
+	w.Line("\t\treturn")
+	w.Line("\t}")
+	w.Line("\t_, _ = io.WriteString(writer, strconv.Quote(graphQLNamesByValue[m.en]))")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *graphQLMarshallerGenerator) generateUnmarshalGQL() {
+	w := g.writer
+	e := g.enum
+	w.Line("// UnmarshalGQL implements gqlgen graphql.Unmarshaler accepting GraphQL enum values,")
+	w.Line("// nil is unmarshalled to nil enum.")
+	w.Line("func (m *" + e.marshallableStruct + ") UnmarshalGQL(v any) error {")
+	w.Line("\tif v == nil {")
+	w.Line("\t\tm.en = nil")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\tname, ok := v.(string)")
+	w.Line("\tif !ok {")
+	w.Line("\t\treturn fmt.Errorf(\"could not unmarshal " + e.Type + " from GraphQL value of type %T\", v)")
+	w.Line("\t}")
+	w.Line("\tvalue, found := valuesByGraphQLName[name]")
+	w.Line("\tif !found {")
+	w.Line("\t\treturn fmt.Errorf(\"could not unmarshal " + e.Type + " from GraphQL: %w: %q\", " +
+		e.invalidNameErrorSentinel + ", name)")
+	w.Line("\t}")
+	w.Line("\tm.en = value")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// generateSchema generates the GraphQL enum SDL.
+func (g *graphQLMarshallerGenerator) generateSchema() {
+	w := g.writer
+	e := g.enum
+	w.Line("# Code generated by " + generatorPackageName + " DO NOT EDIT.")
+	w.LineBreak()
+	w.Line("enum " + e.Type + " {")
+	for _, value := range e.values {
+		w.Line("  " + graphQLName(value.identifier))
+	}
+	w.Line("}")
+}
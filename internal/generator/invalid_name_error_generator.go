@@ -38,17 +38,122 @@ func newInvalidNameErrorGenerator(
 	}
 }
 
+func (g *invalidNameErrorGenerator) imports() []string {
+	return []string{"errors", "strings", "unicode/utf8"}
+}
+
 func (g *invalidNameErrorGenerator) generateInvalidNameError() {
+	g.generateSentinel()
+	g.generateErrorStruct()
+	g.generateAccessors()
+	g.generateSuggestion()
+	g.generateEditDistance()
+	g.generateConstructor()
+}
+
+func (g *invalidNameErrorGenerator) generateSentinel() {
+	w := g.writer
+	e := g.enum
+	w.Line("// " + e.invalidNameErrorSentinel + " is matched by " + e.invalidNameError + " using errors.Is.")
+	w.Line("var " + e.invalidNameErrorSentinel + " = errors.New(\"invalid " + e.Type + "\")")
+	w.LineBreak()
+}
+
+func (g *invalidNameErrorGenerator) generateErrorStruct() {
 	w := g.writer
 	e := g.enum
-	w.Line("type Invalid" + e.Type + "NameError struct {")
+	w.Line("type " + e.invalidNameError + " struct {")
 	w.Line("\tname string")
 	w.Line("}")
 	w.LineBreak()
 	w.Line("func (e " + e.invalidNameError + ") Error() string {")
+	w.Line("\tif suggestion, ok := e.Suggestion(); ok {")
+	w.Line("\t\treturn \"invalid " + e.Type + " name: \\\"\" + e.name + " +
+		"\"\\\", did you mean \\\"\" + suggestion + \"\\\"?\"")
+	w.Line("\t}")
 	w.Line("\treturn \"invalid " + e.Type + " name: \\\"\" + e.name + \"\\\"\"")
 	w.Line("}")
 	w.LineBreak()
+	w.Line("func (e " + e.invalidNameError + ") Is(target error) bool {")
+	w.Line("\treturn target == " + e.invalidNameErrorSentinel)
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *invalidNameErrorGenerator) generateAccessors() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Name returns the name which did not match any " + e.Type + " value.")
+	w.Line("func (e " + e.invalidNameError + ") Name() string {")
+	w.Line("\treturn e.name")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Type returns the enum type name.")
+	w.Line("func (e " + e.invalidNameError + ") Type() string {")
+	w.Line("\treturn \"" + e.Type + "\"")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Allowed returns names of all the " + e.Type + " values.")
+	w.Line("func (e " + e.invalidNameError + ") Allowed() []string {")
+	w.Line("\tvalues := Values()")
+	w.Line("\tallowed := make([]string, 0, len(values))")
+	w.Line("\tfor _, value := range values {")
+	w.Line("\t\tallowed = append(allowed, value.String())")
+	w.Line("\t}")
+	w.Line("\treturn allowed")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *invalidNameErrorGenerator) generateSuggestion() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Suggestion returns the allowed name closest to the invalid name (case-insensitive edit distance),")
+	w.Line("// if there is one close enough.")
+	w.Line("func (e " + e.invalidNameError + ") Suggestion() (string, bool) {")
+	w.Line("\tconst maxDistance = 2")
+	w.Line("\tname := strings.ToLower(e.name)")
+	w.Line("\tsuggestion, suggestionDistance := \"\", maxDistance+1")
+	w.Line("\tfor _, allowed := range e.Allowed() {")
+	w.Line("\t\tdistance := editDistance(name, strings.ToLower(allowed))")
+	w.Line("\t\tif distance < suggestionDistance && distance < utf8.RuneCountInString(allowed) {")
+	w.Line("\t\t\tsuggestion, suggestionDistance = allowed, distance")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.Line("\treturn suggestion, suggestion != \"\"")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// generateEditDistance generates Levenshtein distance function.
+func (g *invalidNameErrorGenerator) generateEditDistance() {
+	w := g.writer
+	w.Line("func editDistance(source, target string) int {")
+	w.Line("\tsourceRunes, targetRunes := []rune(source), []rune(target)")
+	w.Line("\tprevious := make([]int, len(targetRunes)+1)")
+	w.Line("\tcurrent := make([]int, len(targetRunes)+1)")
+	w.Line("\tfor j := range previous {")
+	w.Line("\t\tprevious[j] = j")
+	w.Line("\t}")
+	w.Line("\tfor i := 1; i <= len(sourceRunes); i++ {")
+	w.Line("\t\tcurrent[0] = i")
+	w.Line("\t\tfor j := 1; j <= len(targetRunes); j++ {")
+	w.Line("\t\t\tcost := 1")
+	w.Line("\t\t\tif sourceRunes[i-1] == targetRunes[j-1] {")
+	w.Line("\t\t\t\tcost = 0")
+	w.Line("\t\t\t}")
+	w.Line("\t\t\tcurrent[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)")
+	w.Line("\t\t}")
+	w.Line("\t\tprevious, current = current, previous")
+	w.Line("\t}")
+	w.Line("\treturn previous[len(targetRunes)]")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *invalidNameErrorGenerator) generateConstructor() {
+	w := g.writer
+	e := g.enum
 	w.Line("func " + e.invalidNameErrorConstructor + "(name string) " + e.invalidNameError + " {")
 	w.Line("\treturn " + e.invalidNameError + "{name: name}")
 	w.Line("}")
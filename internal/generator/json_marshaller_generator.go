@@ -38,15 +38,11 @@ func newJSONMarshallerGenerator(
 	}
 }
 
-func (g *jsonMarshallerGenerator) generateImports() {
+func (g *jsonMarshallerGenerator) imports() []string {
 	if !g.enum.Marshalling.JSONOptions.Generate {
-		return
-	}
-	if !g.enum.Marshalling.JSONOptions.NilToUndefined {
-		g.writer.Line("\t\"errors\"")
+		return nil
 	}
-
-	g.writer.Line("\t\"strings\"")
+	return []string{"encoding/json", "errors"}
 }
 
 func (g *jsonMarshallerGenerator) generateToMarshallerDeclaration() {
@@ -60,20 +56,9 @@ func (g *jsonMarshallerGenerator) generateJSONMarshalling() {
 	if !g.enum.Marshalling.JSONOptions.Generate {
 		return
 	}
-	g.generateMarshallableStruct()
 	g.generateMarshalJSON()
 	g.generateUnmarshalJSON()
 	g.generateToJSONMarshallable()
-	g.generateToEnum()
-}
-
-func (g *jsonMarshallerGenerator) generateMarshallableStruct() {
-	w := g.writer
-	e := g.enum
-	w.Line("type " + e.marshallableStruct + " struct {")
-	w.Line("\ten " + e.Type)
-	w.Line("}")
-	w.LineBreak()
 }
 
 func (g *jsonMarshallerGenerator) generateMarshalJSON() {
@@ -83,7 +68,11 @@ func (g *jsonMarshallerGenerator) generateMarshalJSON() {
 	w.Line("\tif b.en == nil {")
 	w.Line("\t\treturn []byte(\"null\"), nil")
 	w.Line("\t}")
-	w.Line("\treturn []byte(\"\\\"\" + b.en.String() + \"\\\"\"), nil")
+	if e.Marshalling.JSONOptions.AsCode {
+		w.Line("\treturn json.Marshal(b.en.Code())")
+	} else {
+		w.Line("\treturn json.Marshal(b.en.String())")
+	}
 	w.Line("}")
 	w.LineBreak()
 }
@@ -91,14 +80,21 @@ func (g *jsonMarshallerGenerator) generateMarshalJSON() {
 func (g *jsonMarshallerGenerator) generateUnmarshalJSON() {
 	w := g.writer
 	e := g.enum
+	if e.Marshalling.JSONOptions.AsCode {
+		w.Line("// UnmarshalJSON accepts JSON integer number and null tokens only.")
+	} else {
+		w.Line("// UnmarshalJSON accepts JSON string and null tokens only.")
+	}
+	w.Line("// Any other token is rejected with *json.UnmarshalTypeError or *json.SyntaxError.")
 	w.Line("func (b *" + e.marshallableStruct + ") UnmarshalJSON(jsonBytes []byte) error {")
 
 	g.generateUnmarshalFromEmptyBytes()
-
-	w.Line("\tjsonString := bytes.NewBuffer(jsonBytes).String()")
-
 	g.generateUnmarshalFromNull()
-	g.generateUnmarshalFromString()
+	if e.Marshalling.JSONOptions.AsCode {
+		g.generateUnmarshalFromCode()
+	} else {
+		g.generateUnmarshalFromString()
+	}
 
 	w.Line("\treturn nil")
 
@@ -113,12 +109,11 @@ func (g *jsonMarshallerGenerator) generateUnmarshalFromEmptyBytes() {
 	// if len(jsonBytes) == 0 {
 	w.Line("\tif len(jsonBytes) == 0 {")
 
-	// b = Undefined
-	if e.Marshalling.JSONOptions.NilToUndefined {
-		w.Line("\t\tb.en = " + e.UndefinedValue)
-	} else { // or just return
-		w.Line("\t\treturn nil")
+	// b = Default or Undefined
+	if value := e.nilValue(e.Marshalling.JSONOptions.NilToDefault, e.Marshalling.JSONOptions.NilToUndefined); value != "" {
+		w.Line("\t\tb.en = " + value)
 	}
+	w.Line("\t\treturn nil")
 
 	// } end if len(jsonBytes) == 0
 	w.Line("\t}")
@@ -130,17 +125,16 @@ func (g *jsonMarshallerGenerator) generateUnmarshalFromNull() {
 	w := g.writer
 	e := g.enum
 
-	// if jsonString == "null" {
-	w.Line("\tif jsonString == \"null\" {")
+	// if string(jsonBytes) == "null" {
+	w.Line("\tif string(jsonBytes) == \"null\" {")
 
-	// b = Undefined
-	if e.Marshalling.JSONOptions.NilToUndefined {
-		w.Line("\t\tb.en = " + e.UndefinedValue)
-	} else { // or just return
-		w.Line("\t\treturn nil")
+	// b = Default or Undefined
+	if value := e.nilValue(e.Marshalling.JSONOptions.NilToDefault, e.Marshalling.JSONOptions.NilToUndefined); value != "" {
+		w.Line("\t\tb.en = " + value)
 	}
+	w.Line("\t\treturn nil")
 
-	// } end if jsonString == "null"
+	// } end if string(jsonBytes) == "null"
 	w.Line("\t}")
 
 	w.LineBreak()
@@ -150,39 +144,53 @@ func (g *jsonMarshallerGenerator) generateUnmarshalFromString() {
 	w := g.writer
 	e := g.enum
 
-	w.Line("\ttrimmedString := strings.Trim(jsonString, \"\\\"\")")
+	// decode JSON string token, including escape sequences
+	w.Line("\tvar name string")
+	w.Line("\tif err := json.Unmarshal(jsonBytes, &name); err != nil {")
+	w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from JSON\"), err)")
+	w.Line("\t}")
+	w.LineBreak()
 
-	// OfOrUndefined
-	if e.Marshalling.JSONOptions.NilToUndefined {
-		w.Line("\torUndefined := OfOrUndefined(trimmedString)")
-		w.Line("\tb.en = orUndefined")
+	// OfOrUnknown or OfOrUndefined
+	if lookup := e.unknownNameLookup(e.Marshalling.JSONOptions.unknownToUndefined()); lookup != "" {
+		w.Line("\tb.en = " + lookup + "(name)")
 	} else { // or fail
-		w.Line("\tvalue, err := Of(trimmedString)")
+		w.Line("\tvalue, err := Of(name)")
 		w.Line("\tif err != nil {")
 		w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from JSON\"), err)")
 		w.Line("\t}")
 		w.Line("\tb.en = value")
 	}
-
-	w.LineBreak()
 }
 
-func (g *jsonMarshallerGenerator) generateToJSONMarshallable() {
+func (g *jsonMarshallerGenerator) generateUnmarshalFromCode() {
 	w := g.writer
 	e := g.enum
 
-	w.Line("func (b " + e.baseStruct + ") ToJSONMarshallable() " + e.marshallableStruct + " {")
-	w.Line("\treturn " + e.marshallableStruct + "{en: b}")
-	w.Line("}")
+	// decode JSON number token, rejecting fractions
+	w.Line("\tvar code int")
+	w.Line("\tif err := json.Unmarshal(jsonBytes, &code); err != nil {")
+	w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from JSON\"), err)")
+	w.Line("\t}")
 	w.LineBreak()
+	w.Line("\tvalue, err := OfCode(code)")
+	w.Line("\tif err != nil {")
+	// Undefined
+	if e.Marshalling.JSONOptions.unknownToUndefined() {
+		w.Line("\t\tvalue = " + e.UndefinedValue)
+	} else { // or fail
+		w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from JSON\"), err)")
+	}
+	w.Line("\t}")
+	w.Line("\tb.en = value")
 }
 
-func (g *jsonMarshallerGenerator) generateToEnum() {
+func (g *jsonMarshallerGenerator) generateToJSONMarshallable() {
 	w := g.writer
 	e := g.enum
 
-	w.Line("func (m " + e.marshallableStruct + ") ToEnum() " + e.Type + " {")
-	w.Line("\treturn m.en")
+	w.Line("func (b " + e.baseStruct + ") ToJSONMarshallable() " + e.marshallableStruct + " {")
+	w.Line("\treturn " + e.marshallableStruct + "{en: b}")
 	w.Line("}")
 	w.LineBreak()
 }
@@ -0,0 +1,224 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// jsonV2File is a file the encoding/json/v2 methods are saved to.
+type jsonV2File struct {
+	buildConstraint string
+	fileSuffix      string
+}
+
+// jsonV2Files hold the same methods, built only with the jsonv2 GOEXPERIMENT (available since Go 1.25).
+// Go 1.27 marks encoding/json/v2 API as Go 1.27 API, so its file constraint has to raise the file language version
+// for modules targeting older Go versions, while the same constraint would exclude the file from Go 1.25 and 1.26.
+// No single constraint builds the methods with both, hence the file per toolchain range.
+var jsonV2Files = []jsonV2File{
+	{buildConstraint: "goexperiment.jsonv2 && !go1.27", fileSuffix: "_jsonv2.go"},
+	{buildConstraint: "goexperiment.jsonv2 && go1.27", fileSuffix: "_jsonv2_go127.go"},
+}
+
+// jsonV2MarshallerGenerator generates encoding/json/v2 MarshalJSONTo and UnmarshalJSONFrom methods.
+// The methods are generated to a separate file guarded by the jsonv2 GOEXPERIMENT build constraint,
+// so the enum still compiles without the experiment.
+type jsonV2MarshallerGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newJSONV2MarshallerGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *jsonV2MarshallerGenerator {
+	return &jsonV2MarshallerGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *jsonV2MarshallerGenerator) imports() []string {
+	imports := make([]string, 0, 4)
+	if !g.enum.Marshalling.JSONOptions.AsCode {
+		imports = append(imports, "bytes")
+	}
+	return append(imports, "encoding/json/jsontext", "encoding/json/v2", "errors")
+}
+
+func (g *jsonV2MarshallerGenerator) generateBuildConstraint(buildConstraint string) {
+	g.writer.Line("//go:build " + buildConstraint)
+	g.writer.LineBreak()
+}
+
+func (g *jsonV2MarshallerGenerator) generateJSONV2Marshalling() {
+	g.generateMarshalJSONTo()
+	g.generateUnmarshalJSONFrom()
+	if g.enum.Nullable {
+		g.generateNullableMarshalJSONTo()
+		g.generateNullableUnmarshalJSONFrom()
+	}
+}
+
+func (g *jsonV2MarshallerGenerator) generateMarshalJSONTo() {
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalJSONTo implements json.MarshalerTo, nil enum is marshalled to null.")
+	w.Line("func (b " + e.marshallableStruct + ") MarshalJSONTo(encoder *jsontext.Encoder) error {")
+	w.Line("\tif b.en == nil {")
+	w.Line("\t\treturn encoder.WriteToken(jsontext.Null)")
+	w.Line("\t}")
+	if e.Marshalling.JSONOptions.AsCode {
+		w.Line("\treturn encoder.WriteToken(jsontext.Int(int64(b.en.Code())))")
+	} else {
+		w.Line("\treturn encoder.WriteToken(jsontext.String(b.en.String()))")
+	}
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *jsonV2MarshallerGenerator) generateUnmarshalJSONFrom() {
+	w := g.writer
+	e := g.enum
+	if e.Marshalling.JSONOptions.AsCode {
+		w.Line("// UnmarshalJSONFrom implements json.UnmarshalerFrom accepting JSON number and null tokens only.")
+	} else {
+		w.Line("// UnmarshalJSONFrom implements json.UnmarshalerFrom accepting JSON string and null tokens only.")
+	}
+	w.Line("// Any other token is skipped and rejected with *json.SemanticError.")
+	w.Line("func (b *" + e.marshallableStruct + ") UnmarshalJSONFrom(decoder *jsontext.Decoder) error {")
+	w.Line("\tswitch kind := decoder.PeekKind(); kind {")
+	w.Line("\tcase jsontext.KindNull:")
+	w.Line("\t\tif _, err := decoder.ReadToken(); err != nil {")
+	w.Line("\t\t\treturn err")
+	w.Line("\t\t}")
+	if value := e.nilValue(e.Marshalling.JSONOptions.NilToDefault, e.Marshalling.JSONOptions.NilToUndefined); value != "" {
+		w.Line("\t\tb.en = " + value)
+	}
+	w.Line("\t\treturn nil")
+	if e.Marshalling.JSONOptions.AsCode {
+		w.Line("\tcase jsontext.KindNumber:")
+	} else {
+		w.Line("\tcase jsontext.KindString:")
+	}
+	w.Line("\tdefault:")
+	w.Line("\t\tif err := decoder.SkipValue(); err != nil {")
+	w.Line("\t\t\treturn err")
+	w.Line("\t\t}")
+	w.Line("\t\treturn &json.SemanticError{")
+	w.Line("\t\t\tJSONKind: kind,")
+	w.Line("\t\t\tErr:      errors.New(\"could not unmarshal " + e.Type + " from JSON\"),")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.LineBreak()
+	if e.Marshalling.JSONOptions.AsCode {
+		g.generateUnmarshalCode()
+	} else {
+		g.generateUnmarshalName()
+	}
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *jsonV2MarshallerGenerator) generateUnmarshalName() {
+	w := g.writer
+	e := g.enum
+	w.Line("\traw, err := decoder.ReadValue()")
+	w.Line("\tif err != nil {")
+	w.Line("\t\treturn err")
+	w.Line("\t}")
+	w.LineBreak()
+	// names are looked up on the raw string bytes, which does not allocate, unless escaped
+	w.Line("\tname := []byte(raw[1 : len(raw)-1])")
+	w.Line("\tif bytes.IndexByte(name, '\\\\') >= 0 {")
+	w.Line("\t\tif name, err = jsontext.AppendUnquote(nil, raw); err != nil {")
+	w.Line("\t\t\treturn err")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.Line("\tif value, ok := allValuesByString[string(name)]; ok {")
+	w.Line("\t\tb.en = value")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+
+	// OfOrUnknown or OfOrUndefined
+	if lookup := e.unknownNameLookup(e.Marshalling.JSONOptions.unknownToUndefined()); lookup != "" {
+		w.Line("\tb.en = " + lookup + "(string(name))")
+	} else { // or fail
+		w.Line("\tvalue, err := Of(string(name))")
+		w.Line("\tif err != nil {")
+		w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from JSON\"), err)")
+		w.Line("\t}")
+		w.Line("\tb.en = value")
+	}
+}
+
+func (g *jsonV2MarshallerGenerator) generateUnmarshalCode() {
+	w := g.writer
+	e := g.enum
+	// decode JSON number token, rejecting fractions
+	w.Line("\tvar code int")
+	w.Line("\tif err := json.UnmarshalDecode(decoder, &code); err != nil {")
+	w.Line("\t\treturn err")
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\tvalue, err := OfCode(code)")
+	w.Line("\tif err != nil {")
+	// Undefined
+	if e.Marshalling.JSONOptions.unknownToUndefined() {
+		w.Line("\t\tvalue = " + e.UndefinedValue)
+	} else { // or fail
+		w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from JSON\"), err)")
+	}
+	w.Line("\t}")
+	w.Line("\tb.en = value")
+}
+
+func (g *jsonV2MarshallerGenerator) generateNullableMarshalJSONTo() {
+	w := g.writer
+	e := g.enum
+	w.Line("func (n " + e.nullableStruct + ") MarshalJSONTo(encoder *jsontext.Encoder) error {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn encoder.WriteToken(jsontext.Null)")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.MarshalJSONTo(encoder)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *jsonV2MarshallerGenerator) generateNullableUnmarshalJSONFrom() {
+	w := g.writer
+	e := g.enum
+	w.Line("func (n *" + e.nullableStruct + ") UnmarshalJSONFrom(decoder *jsontext.Decoder) error {")
+	w.Line("\tif decoder.PeekKind() == jsontext.KindNull {")
+	w.Line("\t\tif _, err := decoder.ReadToken(); err != nil {")
+	w.Line("\t\t\treturn err")
+	w.Line("\t\t}")
+	w.Line("\t\tn." + e.Type + ", n.Valid, n.Present = nil, false, true")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	newNullableGenerator(e, w).
+		generateDelegatedUnmarshal("UnmarshalJSONFrom(decoder)")
+	w.Line("}")
+	w.LineBreak()
+}
@@ -0,0 +1,86 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// marshallableGenerator generates the MarshallableType wrapper shared by all the marshalling formats.
+// The wrapper is required, as unmarshalling needs a pointer receiver and the enum is an interface.
+type marshallableGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newMarshallableGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *marshallableGenerator {
+	return &marshallableGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *marshallableGenerator) generateToMarshallableDeclaration() {
+	if !g.enum.Marshalling.enabled() {
+		return
+	}
+	g.writer.Line("\tToMarshallable() " + g.enum.marshallableStruct)
+}
+
+func (g *marshallableGenerator) generateMarshallable() {
+	if !g.enum.Marshalling.enabled() {
+		return
+	}
+	g.generateMarshallableStruct()
+	g.generateToMarshallable()
+	g.generateToEnum()
+}
+
+func (g *marshallableGenerator) generateMarshallableStruct() {
+	w := g.writer
+	e := g.enum
+	w.Line("type " + e.marshallableStruct + " struct {")
+	w.Line("\ten " + e.Type)
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *marshallableGenerator) generateToMarshallable() {
+	w := g.writer
+	e := g.enum
+
+	w.Line("func (b " + e.baseStruct + ") ToMarshallable() " + e.marshallableStruct + " {")
+	w.Line("\treturn " + e.marshallableStruct + "{en: b}")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *marshallableGenerator) generateToEnum() {
+	w := g.writer
+	e := g.enum
+
+	w.Line("func (m " + e.marshallableStruct + ") ToEnum() " + e.Type + " {")
+	w.Line("\treturn m.en")
+	w.Line("}")
+	w.LineBreak()
+}
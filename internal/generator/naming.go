@@ -0,0 +1,116 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NamingStrategy derives value names from value identifiers.
+type NamingStrategy string
+
+const (
+	// NamingIdentifier uses the identifier as is, e.g. InProgress.
+	NamingIdentifier NamingStrategy = ""
+	// NamingSnakeCase derives snake case names, e.g. in_progress.
+	NamingSnakeCase NamingStrategy = "snake_case"
+	// NamingKebabCase derives kebab case names, e.g. in-progress.
+	NamingKebabCase NamingStrategy = "kebab-case"
+	// NamingScreamingSnakeCase derives screaming snake case names, e.g. IN_PROGRESS.
+	NamingScreamingSnakeCase NamingStrategy = "SCREAMING_SNAKE"
+	// NamingLowerCamelCase derives lower camel case names, e.g. inProgress.
+	NamingLowerCamelCase NamingStrategy = "lowerCamel"
+)
+
+func (n NamingStrategy) valid() bool {
+	switch n {
+	case NamingIdentifier, NamingSnakeCase, NamingKebabCase, NamingScreamingSnakeCase, NamingLowerCamelCase:
+		return true
+	default:
+		return false
+	}
+}
+
+// name returns the explicit value name or derives it from the value identifier.
+func (n NamingStrategy) name(value Value) string {
+	if value.Name != "" {
+		return value.Name
+	}
+
+	switch n {
+	case NamingSnakeCase:
+		return strings.ToLower(strings.Join(splitWords(value.Identifier), "_"))
+	case NamingKebabCase:
+		return strings.ToLower(strings.Join(splitWords(value.Identifier), "-"))
+	case NamingScreamingSnakeCase:
+		return strings.ToUpper(strings.Join(splitWords(value.Identifier), "_"))
+	case NamingLowerCamelCase:
+		words := splitWords(value.Identifier)
+		if len(words) == 0 {
+			return ""
+		}
+		words[0] = strings.ToLower(words[0])
+		for i := 1; i < len(words); i++ {
+			runes := []rune(words[i])
+			runes[0] = unicode.ToUpper(runes[0])
+			words[i] = string(runes)
+		}
+		return strings.Join(words, "")
+	default:
+		return value.Identifier
+	}
+}
+
+// splitWords splits the identifier into words on underscores and case changes,
+// keeping acronyms together, e.g. HTTPServer_v2 -> HTTP, Server, v2.
+func splitWords(identifier string) []string {
+	words := make([]string, 0)
+	for _, part := range strings.Split(identifier, "_") {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			if isWordBoundary(runes, i) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		if start < len(runes) {
+			words = append(words, string(runes[start:]))
+		}
+	}
+	return words
+}
+
+func isWordBoundary(runes []rune, i int) bool {
+	previous, current := runes[i-1], runes[i]
+	if !unicode.IsUpper(current) {
+		return false
+	}
+	if !unicode.IsUpper(previous) {
+		return true
+	}
+	// end of an acronym, e.g. the S in HTTPServer
+	return i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
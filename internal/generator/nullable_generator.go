@@ -0,0 +1,256 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// nullableGenerator generates the NullType wrapper (modelled on sql.NullString)
+// distinguishing absent (null) values from the present ones.
+// Marshalling methods delegate to the MarshallableType for present values.
+type nullableGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newNullableGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *nullableGenerator {
+	return &nullableGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *nullableGenerator) generateNullable() {
+	if !g.enum.Nullable {
+		return
+	}
+	g.generateNullableStruct()
+	g.generateJSON()
+	g.generateText()
+	g.generateYAML()
+	g.generateXML()
+	g.generateBinary()
+	g.generateSQL()
+}
+
+func (g *nullableGenerator) generateNullableStruct() {
+	w := g.writer
+	e := g.enum
+	w.Line("// " + e.nullableStruct + " represents " + e.Type + " that may be null.")
+	w.Line("// Valid is true if " + e.Type + " is not null.")
+	w.Line("// Present is true if " + e.nullableStruct + " was unmarshalled, even from null,")
+	w.Line("// which tells a missing JSON field apart from an explicit null.")
+	w.Line("type " + e.nullableStruct + " struct {")
+	w.Line("\t" + e.Type + "   " + e.Type)
+	w.Line("\tValid   bool")
+	w.Line("\tPresent bool")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *nullableGenerator) generateJSON() {
+	if !g.enum.Marshalling.JSONOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("func (n " + e.nullableStruct + ") MarshalJSON() ([]byte, error) {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn []byte(\"null\"), nil")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.MarshalJSON()")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n *" + e.nullableStruct + ") UnmarshalJSON(jsonBytes []byte) error {")
+	w.Line("\tif len(jsonBytes) == 0 || string(jsonBytes) == \"null\" {")
+	w.Line("\t\tn." + e.Type + ", n.Valid, n.Present = nil, false, true")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	g.generateDelegatedUnmarshal("UnmarshalJSON(jsonBytes)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *nullableGenerator) generateText() {
+	if !g.enum.Marshalling.TextOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("func (n " + e.nullableStruct + ") MarshalText() ([]byte, error) {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn []byte{}, nil")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.MarshalText()")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n *" + e.nullableStruct + ") UnmarshalText(text []byte) error {")
+	w.Line("\tif len(text) == 0 {")
+	w.Line("\t\tn." + e.Type + ", n.Valid, n.Present = nil, false, true")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	g.generateDelegatedUnmarshal("UnmarshalText(text)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *nullableGenerator) generateYAML() {
+	if !g.enum.Marshalling.YAMLOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("func (n " + e.nullableStruct + ") MarshalYAML() (any, error) {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn nil, nil")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.MarshalYAML()")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n *" + e.nullableStruct + ") UnmarshalYAML(node *yaml.Node) error {")
+	w.Line("\tif node.Kind == yaml.ScalarNode && node.ShortTag() == \"!!null\" {")
+	w.Line("\t\tn." + e.Type + ", n.Valid, n.Present = nil, false, true")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	g.generateDelegatedUnmarshal("UnmarshalYAML(node)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *nullableGenerator) generateXML() {
+	if !g.enum.Marshalling.XMLOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("func (n " + e.nullableStruct + ") MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.MarshalXML(encoder, start)")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n *" + e.nullableStruct + ") UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {")
+	w.Line("\tvar name string")
+	w.Line("\tif err := decoder.DecodeElement(&name, &start); err != nil {")
+	w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from XML\"), err)")
+	w.Line("\t}")
+	w.Line("\treturn n.UnmarshalXMLAttr(xml.Attr{Name: start.Name, Value: name})")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n " + e.nullableStruct + ") MarshalXMLAttr(name xml.Name) (xml.Attr, error) {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn xml.Attr{}, nil")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.MarshalXMLAttr(name)")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n *" + e.nullableStruct + ") UnmarshalXMLAttr(attr xml.Attr) error {")
+	w.Line("\tif attr.Value == \"\" {")
+	w.Line("\t\tn." + e.Type + ", n.Valid, n.Present = nil, false, true")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	g.generateDelegatedUnmarshal("UnmarshalXMLAttr(attr)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *nullableGenerator) generateBinary() {
+	if !g.enum.Marshalling.BinaryOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("func (n " + e.nullableStruct + ") MarshalBinary() ([]byte, error) {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn []byte{}, nil")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.MarshalBinary()")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n *" + e.nullableStruct + ") UnmarshalBinary(data []byte) error {")
+	w.Line("\tif len(data) == 0 {")
+	w.Line("\t\tn." + e.Type + ", n.Valid, n.Present = nil, false, true")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	g.generateDelegatedUnmarshal("UnmarshalBinary(data)")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n " + e.nullableStruct + ") GobEncode() ([]byte, error) {")
+	w.Line("\treturn n.MarshalBinary()")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (n *" + e.nullableStruct + ") GobDecode(data []byte) error {")
+	w.Line("\treturn n.UnmarshalBinary(data)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *nullableGenerator) generateSQL() {
+	if !g.enum.Marshalling.SQLOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// Scan implements sql.Scanner, NULL is scanned as not valid.")
+	w.Line("func (n *" + e.nullableStruct + ") Scan(src any) error {")
+	w.Line("\tif src == nil {")
+	w.Line("\t\tn." + e.Type + ", n.Valid, n.Present = nil, false, true")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+	g.generateDelegatedUnmarshal("Scan(src)")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Value implements driver.Valuer, not valid value is stored as NULL.")
+	w.Line("func (n " + e.nullableStruct + ") Value() (driver.Value, error) {")
+	w.Line("\tif !n.Valid {")
+	w.Line("\t\treturn nil, nil")
+	w.Line("\t}")
+	w.Line("\treturn " + e.marshallableStruct + "{en: n." + e.Type + "}.Value()")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *nullableGenerator) generateDelegatedUnmarshal(unmarshalCall string) {
+	w := g.writer
+	e := g.enum
+	w.Line("\tvar marshallable " + e.marshallableStruct)
+	w.Line("\tif err := marshallable." + unmarshalCall + "; err != nil {")
+	w.Line("\t\treturn err")
+	w.Line("\t}")
+	w.Line("\tn." + e.Type + ", n.Valid, n.Present = marshallable.ToEnum(), true, true")
+	w.Line("\treturn nil")
+}
@@ -38,9 +38,53 @@ func newOfStringGenerator(
 	}
 }
 
+func (g *ofStringGenerator) imports() []string {
+	if !g.enum.Parsing.enabled() {
+		return nil
+	}
+	return []string{"strings"}
+}
+
 func (g *ofStringGenerator) generateOfStringMethods() {
+	g.generateNormalizeName()
 	g.generateOfString()
 	g.generateOfOrUndefined()
+	g.generateOfOrUnknown()
+}
+
+// generateNormalizeName generates the function normalizing names for lenient parsing,
+// it must be kept in sync with ParseOptions.normalize.
+func (g *ofStringGenerator) generateNormalizeName() {
+	o := g.enum.Parsing
+	if !o.enabled() {
+		return
+	}
+
+	w := g.writer
+	w.Line("func normalizeName(name string) string {")
+	if o.TrimSpace {
+		w.Line("\tname = strings.TrimSpace(name)")
+	}
+	if o.IgnoreCase {
+		w.Line("\tname = strings.ToLower(name)")
+	}
+	if o.IgnoreSeparators {
+		w.Line("\tname = strings.NewReplacer(\"-\", \"_\", \" \", \"_\").Replace(name)")
+	}
+	w.Line("\treturn name")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *ofStringGenerator) generateNormalizedLookup(found string) {
+	if !g.enum.Parsing.enabled() {
+		return
+	}
+
+	w := g.writer
+	w.Line("\tif value, ok := allValuesByNormalizedString[normalizeName(name)]; ok {")
+	w.Line("\t\treturn " + found)
+	w.Line("\t}")
 }
 
 func (g *ofStringGenerator) generateOfString() {
@@ -50,6 +94,7 @@ func (g *ofStringGenerator) generateOfString() {
 	w.Line("\tif value, ok := allValuesByString[name]; ok {")
 	w.Line("\t\treturn value, nil")
 	w.Line("\t}")
+	g.generateNormalizedLookup("value, nil")
 	w.Line("\treturn nil, " + e.invalidNameErrorConstructor + "(name)")
 	w.Line("}")
 	w.LineBreak()
@@ -66,7 +111,27 @@ func (g *ofStringGenerator) generateOfOrUndefined() {
 	w.Line("\tif value, ok := allValuesByString[name]; ok {")
 	w.Line("\t\treturn value")
 	w.Line("\t}")
+	g.generateNormalizedLookup("value")
 	w.Line("\treturn " + e.UndefinedValue)
 	w.Line("}")
 	w.LineBreak()
 }
+
+func (g *ofStringGenerator) generateOfOrUnknown() {
+	if !g.enum.PreserveUnknown {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// OfOrUnknown maps the name to " + e.Type + " value,")
+	w.Line("// unknown names are preserved as a value reporting IsKnown() == false.")
+	w.Line("func OfOrUnknown(name string) " + e.Type + " {")
+	w.Line("\tif value, ok := allValuesByString[name]; ok {")
+	w.Line("\t\treturn value")
+	w.Line("\t}")
+	g.generateNormalizedLookup("value")
+	w.Line("\treturn " + e.unknownStruct + "{" + e.baseStruct + ": " + e.baseStruct + "{name: name, ordinal: -1}}")
+	w.Line("}")
+	w.LineBreak()
+}
@@ -0,0 +1,189 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import "strconv"
+
+// ordinalGenerator generates declaration order based functions: ordinal lookups, comparison,
+// navigation and iteration. The ordinal is the value position in Values, starting from 0.
+type ordinalGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newOrdinalGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *ordinalGenerator {
+	return &ordinalGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *ordinalGenerator) imports() []string {
+	return []string{"cmp", "fmt", "iter"}
+}
+
+func (g *ordinalGenerator) generateOrdinalDeclaration() {
+	g.writer.Line("\tOrdinal() int")
+}
+
+func (g *ordinalGenerator) generateOrdinalMethod() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Ordinal returns the value position in Values, starting from 0.")
+	w.Line("func (b " + e.baseStruct + ") Ordinal() int {")
+	w.Line("\treturn b.ordinal")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// generateValuesByOrdinal generates the ordinal lookup, to be placed in the values var block.
+// It is an array, so it can't be modified and is iterated without allocating.
+func (g *ordinalGenerator) generateValuesByOrdinal() {
+	w := g.writer
+	e := g.enum
+	w.LineBreak()
+	w.Line("\tvaluesByOrdinal = [" + strconv.Itoa(len(e.values)) + "]" + e.Type + "{")
+	for _, value := range e.values {
+		w.Line("\t\t" + value.identifier + ",")
+	}
+	w.Line("\t}")
+}
+
+func (g *ordinalGenerator) generateOrdinalFunctions() {
+	g.generateFromOrdinal()
+	g.generateCompare()
+	g.generateFirstLast()
+	g.generateNextPrev()
+	g.generateAll()
+}
+
+func (g *ordinalGenerator) generateFromOrdinal() {
+	w := g.writer
+	e := g.enum
+	w.Line("// FromOrdinal returns the value at the ordinal position,")
+	w.Line("// ordinals out of range are rejected with error matching " + e.invalidNameErrorSentinel + ".")
+	w.Line("func FromOrdinal(ordinal int) (" + e.Type + ", error) {")
+	w.Line("\tif ordinal < 0 || ordinal >= len(valuesByOrdinal) {")
+	w.Line("\t\treturn nil, fmt.Errorf(\"%w: ordinal %d out of range [0, %d)\", " +
+		e.invalidNameErrorSentinel + ", ordinal, len(valuesByOrdinal))")
+	w.Line("\t}")
+	w.Line("\treturn valuesByOrdinal[ordinal], nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *ordinalGenerator) generateCompare() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Compare compares the values by ordinal, to be used with slices.SortFunc.")
+	if e.PreserveUnknown {
+		w.Line("// nil and unknown values are ordered before all the values.")
+	} else {
+		w.Line("// nil is ordered before all the values.")
+	}
+	w.Line("func Compare(a, b " + e.Type + ") int {")
+	w.Line("\treturn cmp.Compare(ordinalOf(a), ordinalOf(b))")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func ordinalOf(value " + e.Type + ") int {")
+	w.Line("\tif value == nil {")
+	w.Line("\t\treturn -1")
+	w.Line("\t}")
+	w.Line("\treturn value.Ordinal()")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *ordinalGenerator) generateFirstLast() {
+	w := g.writer
+	e := g.enum
+	w.Line("// First returns the first declared value.")
+	w.Line("func First() " + e.Type + " {")
+	w.Line("\treturn valuesByOrdinal[0]")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Last returns the last declared value.")
+	w.Line("func Last() " + e.Type + " {")
+	w.Line("\treturn valuesByOrdinal[len(valuesByOrdinal)-1]")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *ordinalGenerator) generateNextPrev() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Next returns the value declared after the value, false if the value is the last one or " +
+		g.outOfOrder() + ".")
+	w.Line("func Next(value " + e.Type + ") (" + e.Type + ", bool) {")
+	w.Line("\tif value == nil" + g.unknownCondition() + " || value.Ordinal() == len(valuesByOrdinal)-1 {")
+	w.Line("\t\treturn nil, false")
+	w.Line("\t}")
+	w.Line("\treturn valuesByOrdinal[value.Ordinal()+1], true")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Prev returns the value declared before the value, false if the value is the first one or " +
+		g.outOfOrder() + ".")
+	w.Line("func Prev(value " + e.Type + ") (" + e.Type + ", bool) {")
+	w.Line("\tif value == nil" + g.unknownCondition() + " || value.Ordinal() == 0 {")
+	w.Line("\t\treturn nil, false")
+	w.Line("\t}")
+	w.Line("\treturn valuesByOrdinal[value.Ordinal()-1], true")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// outOfOrder describes the values without the declaration order neighbours.
+func (g *ordinalGenerator) outOfOrder() string {
+	if g.enum.PreserveUnknown {
+		return "nil or unknown"
+	}
+	return "nil"
+}
+
+// unknownCondition returns the Next and Prev condition rejecting unknown values, which are not declared.
+func (g *ordinalGenerator) unknownCondition() string {
+	if !g.enum.PreserveUnknown {
+		return ""
+	}
+	return " || !value.IsKnown()"
+}
+
+func (g *ordinalGenerator) generateAll() {
+	w := g.writer
+	e := g.enum
+	w.Line("// All iterates over ordinals and values in the declaration order without allocating.")
+	w.Line("func All() iter.Seq2[int, " + e.Type + "] {")
+	w.Line("\treturn func(yield func(int, " + e.Type + ") bool) {")
+	w.Line("\t\tfor ordinal, value := range valuesByOrdinal {")
+	w.Line("\t\t\tif !yield(ordinal, value) {")
+	w.Line("\t\t\t\treturn")
+	w.Line("\t\t\t}")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.Line("}")
+	w.LineBreak()
+}
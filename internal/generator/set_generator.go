@@ -0,0 +1,347 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+import "strconv"
+
+// setWordSize is the number of values stored in a single bitset word.
+const setWordSize = 64
+
+// setGenerator generates the TypeSet type, an immutable set of values backed by a bitset
+// indexed by the value ordinal. Marshalling methods delegate to the MarshallableType for the set elements.
+type setGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newSetGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *setGenerator {
+	return &setGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *setGenerator) imports() []string {
+	if !g.enum.Set {
+		return nil
+	}
+	return []string{"iter", "math/bits", "strings"}
+}
+
+func (g *setGenerator) generateSet() {
+	if !g.enum.Set {
+		return
+	}
+	g.generateSetStruct()
+	g.generateEmptyAllSet()
+	g.generateAddRemove()
+	g.generateContains()
+	g.generateSetAlgebra()
+	g.generateLen()
+	g.generateAll()
+	g.generateString()
+	g.generateJSON()
+	g.generateText()
+	g.generateSQL()
+	g.generateUnmarshalElements()
+}
+
+func (g *setGenerator) generateSetStruct() {
+	w := g.writer
+	e := g.enum
+	words := (len(e.values) + setWordSize - 1) / setWordSize
+	w.Line("// " + e.setStruct + " is a set of " + e.Type + " values backed by a bitset indexed by the value ordinal.")
+	w.Line("// Sets are immutable values comparable with ==, the zero value is an empty set.")
+	w.Line("type " + e.setStruct + " struct {")
+	w.Line("\twords [" + strconv.Itoa(words) + "]uint64")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateEmptyAllSet() {
+	w := g.writer
+	e := g.enum
+	w.Line("// EmptySet returns the set without any values.")
+	w.Line("func EmptySet() " + e.setStruct + " {")
+	w.Line("\treturn " + e.setStruct + "{}")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// AllSet returns the set of all the values.")
+	w.Line("func AllSet() " + e.setStruct + " {")
+	w.Line("\treturn EmptySet().Add(valuesByOrdinal[:]...)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateAddRemove() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Add returns the set with the values added, " + g.notStorable() + " values are ignored.")
+	w.Line("func (s " + e.setStruct + ") Add(values ..." + e.Type + ") " + e.setStruct + " {")
+	w.Line("\tfor _, value := range values {")
+	w.Line("\t\tif ordinal := ordinalOf(value); ordinal >= 0 {")
+	w.Line("\t\t\ts.words[ordinal/64] |= 1 << (ordinal % 64)")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.Line("\treturn s")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Remove returns the set with the values removed.")
+	w.Line("func (s " + e.setStruct + ") Remove(values ..." + e.Type + ") " + e.setStruct + " {")
+	w.Line("\tfor _, value := range values {")
+	w.Line("\t\tif ordinal := ordinalOf(value); ordinal >= 0 {")
+	w.Line("\t\t\ts.words[ordinal/64] &^= 1 << (ordinal % 64)")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.Line("\treturn s")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateContains() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Contains reports whether the value is in the set.")
+	w.Line("func (s " + e.setStruct + ") Contains(value " + e.Type + ") bool {")
+	w.Line("\tordinal := ordinalOf(value)")
+	w.Line("\treturn ordinal >= 0 && s.words[ordinal/64]&(1<<(ordinal%64)) != 0")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateSetAlgebra() {
+	g.generateSetOperation("Union", "in either of the sets", "|=")
+	g.generateSetOperation("Intersect", "in both of the sets", "&=")
+	g.generateSetOperation("Difference", "in the set, but not in the other set", "&^=")
+}
+
+func (g *setGenerator) generateSetOperation(method, description, operator string) {
+	w := g.writer
+	e := g.enum
+	w.Line("// " + method + " returns the set of the values " + description + ".")
+	w.Line("func (s " + e.setStruct + ") " + method + "(other " + e.setStruct + ") " + e.setStruct + " {")
+	w.Line("\tfor i := range s.words {")
+	w.Line("\t\ts.words[i] " + operator + " other.words[i]")
+	w.Line("\t}")
+	w.Line("\treturn s")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateLen() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Len returns the number of the values in the set.")
+	w.Line("func (s " + e.setStruct + ") Len() int {")
+	w.Line("\tlength := 0")
+	w.Line("\tfor _, word := range s.words {")
+	w.Line("\t\tlength += bits.OnesCount64(word)")
+	w.Line("\t}")
+	w.Line("\treturn length")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateAll() {
+	w := g.writer
+	e := g.enum
+	w.Line("// All iterates over the set values in the declaration order.")
+	w.Line("func (s " + e.setStruct + ") All() iter.Seq[" + e.Type + "] {")
+	w.Line("\treturn func(yield func(" + e.Type + ") bool) {")
+	w.Line("\t\tfor _, value := range valuesByOrdinal {")
+	w.Line("\t\t\tif s.Contains(value) && !yield(value) {")
+	w.Line("\t\t\t\treturn")
+	w.Line("\t\t\t}")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateString() {
+	w := g.writer
+	e := g.enum
+	w.Line("// String returns the comma-separated names of the set values in the declaration order.")
+	w.Line("func (s " + e.setStruct + ") String() string {")
+	g.generateJoin("value.String()", "")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// generateJoin generates joining the set values mapped by the element expression with commas,
+// returned together with the values following the joined string (e.g. nil error).
+func (g *setGenerator) generateJoin(element, returned string) {
+	w := g.writer
+	w.Line("\telements := make([]string, 0, s.Len())")
+	w.Line("\tfor value := range s.All() {")
+	w.Line("\t\telements = append(elements, " + element + ")")
+	w.Line("\t}")
+	w.Line("\treturn strings.Join(elements, \",\")" + returned)
+}
+
+func (g *setGenerator) generateJSON() {
+	if !g.enum.Marshalling.JSONOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalJSON marshals the set as JSON array of the values in the declaration order.")
+	w.Line("func (s " + e.setStruct + ") MarshalJSON() ([]byte, error) {")
+	w.Line("\tvalues := make([]" + e.marshallableStruct + ", 0, s.Len())")
+	w.Line("\tfor value := range s.All() {")
+	w.Line("\t\tvalues = append(values, " + e.marshallableStruct + "{en: value})")
+	w.Line("\t}")
+	w.Line("\treturn json.Marshal(values)")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// UnmarshalJSON unmarshals the set from JSON array of the values, null is unmarshalled to an empty set.")
+	w.Line("// The values are unmarshalled the same way as " + e.marshallableStruct + ", " + g.notStorable() +
+		" values are ignored.")
+	w.Line("func (s *" + e.setStruct + ") UnmarshalJSON(jsonBytes []byte) error {")
+	w.Line("\tvar values []" + e.marshallableStruct)
+	w.Line("\tif err := json.Unmarshal(jsonBytes, &values); err != nil {")
+	w.Line("\t\treturn err")
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\tset := EmptySet()")
+	w.Line("\tfor _, value := range values {")
+	w.Line("\t\tset = set.Add(value.ToEnum())")
+	w.Line("\t}")
+	w.Line("\t*s = set")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateText() {
+	if !g.enum.Marshalling.TextOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalText marshals the set as the comma-separated names of the values in the declaration order.")
+	w.Line("func (s " + e.setStruct + ") MarshalText() ([]byte, error) {")
+	w.Line("\treturn []byte(s.String()), nil")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// UnmarshalText unmarshals the set from the comma-separated names, empty text is unmarshalled")
+	w.Line("// to an empty set. The names are unmarshalled the same way as " + e.marshallableStruct + ", " +
+		g.notStorable() + " values are ignored.")
+	w.Line("func (s *" + e.setStruct + ") UnmarshalText(text []byte) error {")
+	w.Line("\treturn s.unmarshalElements(string(text), func(marshallable *" + e.marshallableStruct +
+		", element string) error {")
+	w.Line("\t\treturn marshallable.UnmarshalText([]byte(element))")
+	w.Line("\t})")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *setGenerator) generateSQL() {
+	if !g.enum.Marshalling.SQLOptions.Generate {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// Scan implements sql.Scanner, accepting comma-separated " + g.sqlElements() +
+		" as string, []byte and nil (NULL) values.")
+	w.Line("// NULL and empty value are scanned to an empty set, the elements are scanned the same way as " +
+		e.marshallableStruct + ".")
+	w.Line("func (s *" + e.setStruct + ") Scan(src any) error {")
+	w.Line("\tvar text string")
+	w.Line("\tswitch value := src.(type) {")
+	w.Line("\tcase nil:")
+	w.Line("\t\t*s = EmptySet()")
+	w.Line("\t\treturn nil")
+	w.Line("\tcase string:")
+	w.Line("\t\ttext = value")
+	w.Line("\tcase []byte:")
+	w.Line("\t\ttext = string(value)")
+	w.Line("\tdefault:")
+	w.Line("\t\treturn fmt.Errorf(\"could not scan " + e.setStruct + " from SQL value of type %T\", src)")
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\treturn s.unmarshalElements(text, func(marshallable *" + e.marshallableStruct +
+		", element string) error {")
+	w.Line("\t\treturn marshallable.Scan(element)")
+	w.Line("\t})")
+	w.Line("}")
+	w.LineBreak()
+	w.Line("// Value implements driver.Valuer, storing comma-separated " + g.sqlElements() +
+		" in the declaration order.")
+	w.Line("func (s " + e.setStruct + ") Value() (driver.Value, error) {")
+	if e.Marshalling.SQLOptions.AsCode {
+		g.generateJoin("strconv.Itoa(value.Code())", ", nil")
+	} else {
+		w.Line("\treturn s.String(), nil")
+	}
+	w.Line("}")
+	w.LineBreak()
+}
+
+// generateUnmarshalElements generates the comma-separated elements unmarshalling shared by text and SQL.
+func (g *setGenerator) generateUnmarshalElements() {
+	if !g.enum.Set || !(g.enum.Marshalling.TextOptions.Generate || g.enum.Marshalling.SQLOptions.Generate) {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("func (s *" + e.setStruct + ") unmarshalElements(text string, unmarshal func(*" +
+		e.marshallableStruct + ", string) error) error {")
+	w.Line("\tset := EmptySet()")
+	w.Line("\tif text != \"\" {")
+	w.Line("\t\tfor _, element := range strings.Split(text, \",\") {")
+	w.Line("\t\t\tvar marshallable " + e.marshallableStruct)
+	w.Line("\t\t\tif err := unmarshal(&marshallable, element); err != nil {")
+	w.Line("\t\t\t\treturn err")
+	w.Line("\t\t\t}")
+	w.Line("\t\t\tset = set.Add(marshallable.ToEnum())")
+	w.Line("\t\t}")
+	w.Line("\t}")
+	w.Line("\t*s = set")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// notStorable describes the values the set can't hold.
+func (g *setGenerator) notStorable() string {
+	if g.enum.PreserveUnknown {
+		return "nil and unknown"
+	}
+	return "nil"
+}
+
+func (g *setGenerator) sqlElements() string {
+	if g.enum.Marshalling.SQLOptions.AsCode {
+		return "codes"
+	}
+	return "names"
+}
@@ -0,0 +1,174 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+type sqlMarshallerGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newSQLMarshallerGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *sqlMarshallerGenerator {
+	return &sqlMarshallerGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *sqlMarshallerGenerator) imports() []string {
+	if !g.enum.Marshalling.SQLOptions.Generate {
+		return nil
+	}
+	if g.enum.Marshalling.SQLOptions.AsCode {
+		return []string{"database/sql/driver", "errors", "fmt", "strconv"}
+	}
+	if g.enum.unknownNameLookup(g.enum.Marshalling.SQLOptions.UnknownToUndefined) != "" {
+		return []string{"database/sql/driver", "fmt"}
+	}
+	return []string{"database/sql/driver", "errors", "fmt"}
+}
+
+func (g *sqlMarshallerGenerator) generateSQLMarshalling() {
+	if !g.enum.Marshalling.SQLOptions.Generate {
+		return
+	}
+	if g.enum.Marshalling.SQLOptions.AsCode {
+		g.generateScanCode()
+		g.generateValueCode()
+		return
+	}
+	g.generateScan()
+	g.generateValue()
+}
+
+func (g *sqlMarshallerGenerator) generateScan() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Scan implements sql.Scanner, accepting string, []byte and nil (NULL) values.")
+	w.Line("func (m *" + e.marshallableStruct + ") Scan(src any) error {")
+	w.Line("\tvar name string")
+	w.Line("\tswitch value := src.(type) {")
+	w.Line("\tcase nil:")
+	if value := e.nilValue(e.Marshalling.SQLOptions.NullToDefault, e.Marshalling.SQLOptions.NullToUndefined); value != "" {
+		w.Line("\t\tm.en = " + value)
+	} else {
+		w.Line("\t\tm.en = nil")
+	}
+	w.Line("\t\treturn nil")
+	w.Line("\tcase string:")
+	w.Line("\t\tname = value")
+	w.Line("\tcase []byte:")
+	w.Line("\t\tname = string(value)")
+	w.Line("\tdefault:")
+	w.Line("\t\treturn fmt.Errorf(\"could not scan " + e.Type + " from SQL value of type %T\", src)")
+	w.Line("\t}")
+	w.LineBreak()
+	// OfOrUnknown or OfOrUndefined
+	if lookup := e.unknownNameLookup(e.Marshalling.SQLOptions.UnknownToUndefined); lookup != "" {
+		w.Line("\tm.en = " + lookup + "(name)")
+	} else { // or fail
+		w.Line("\tvalue, err := Of(name)")
+		w.Line("\tif err != nil {")
+		w.Line("\t\treturn errors.Join(errors.New(\"could not scan " + e.Type + " from SQL\"), err)")
+		w.Line("\t}")
+		w.Line("\tm.en = value")
+	}
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *sqlMarshallerGenerator) generateValue() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Value implements driver.Valuer, nil enum is stored as NULL.")
+	w.Line("func (m " + e.marshallableStruct + ") Value() (driver.Value, error) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn nil, nil")
+	w.Line("\t}")
+	w.Line("\treturn m.en.String(), nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *sqlMarshallerGenerator) generateScanCode() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Scan implements sql.Scanner, accepting int64, numeric string, numeric []byte and nil (NULL) values.")
+	w.Line("func (m *" + e.marshallableStruct + ") Scan(src any) error {")
+	w.Line("\tvar code int")
+	w.Line("\tswitch value := src.(type) {")
+	w.Line("\tcase nil:")
+	if value := e.nilValue(e.Marshalling.SQLOptions.NullToDefault, e.Marshalling.SQLOptions.NullToUndefined); value != "" {
+		w.Line("\t\tm.en = " + value)
+	} else {
+		w.Line("\t\tm.en = nil")
+	}
+	w.Line("\t\treturn nil")
+	w.Line("\tcase int64:")
+	w.Line("\t\tcode = int(value)")
+	for _, textType := range []struct{ name, conversion string }{
+		{name: "string", conversion: "value"},
+		{name: "[]byte", conversion: "string(value)"},
+	} {
+		w.Line("\tcase " + textType.name + ":")
+		w.Line("\t\tparsed, err := strconv.Atoi(" + textType.conversion + ")")
+		w.Line("\t\tif err != nil {")
+		w.Line("\t\t\treturn errors.Join(errors.New(\"could not scan " + e.Type + " from SQL\"), err)")
+		w.Line("\t\t}")
+		w.Line("\t\tcode = parsed")
+	}
+	w.Line("\tdefault:")
+	w.Line("\t\treturn fmt.Errorf(\"could not scan " + e.Type + " from SQL value of type %T\", src)")
+	w.Line("\t}")
+	w.LineBreak()
+	w.Line("\tvalue, err := OfCode(code)")
+	w.Line("\tif err != nil {")
+	// Undefined
+	if e.Marshalling.SQLOptions.UnknownToUndefined {
+		w.Line("\t\tvalue = " + e.UndefinedValue)
+	} else { // or fail
+		w.Line("\t\treturn errors.Join(errors.New(\"could not scan " + e.Type + " from SQL\"), err)")
+	}
+	w.Line("\t}")
+	w.Line("\tm.en = value")
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *sqlMarshallerGenerator) generateValueCode() {
+	w := g.writer
+	e := g.enum
+	w.Line("// Value implements driver.Valuer storing the value code, nil enum is stored as NULL.")
+	w.Line("func (m " + e.marshallableStruct + ") Value() (driver.Value, error) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn nil, nil")
+	w.Line("\t}")
+	w.Line("\treturn int64(m.en.Code()), nil")
+	w.Line("}")
+	w.LineBreak()
+}
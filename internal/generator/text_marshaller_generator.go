@@ -0,0 +1,97 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+type textMarshallerGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newTextMarshallerGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *textMarshallerGenerator {
+	return &textMarshallerGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *textMarshallerGenerator) imports() []string {
+	if !g.enum.Marshalling.TextOptions.Generate ||
+		g.enum.unknownNameLookup(g.enum.Marshalling.TextOptions.unknownToUndefined()) != "" {
+		return nil
+	}
+	return []string{"errors"}
+}
+
+func (g *textMarshallerGenerator) generateTextMarshalling() {
+	if !g.enum.Marshalling.TextOptions.Generate {
+		return
+	}
+	g.generateMarshalText()
+	g.generateUnmarshalText()
+}
+
+func (g *textMarshallerGenerator) generateMarshalText() {
+	w := g.writer
+	e := g.enum
+	w.Line("func (m " + e.marshallableStruct + ") MarshalText() ([]byte, error) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn []byte{}, nil")
+	w.Line("\t}")
+	w.Line("\treturn []byte(m.en.String()), nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *textMarshallerGenerator) generateUnmarshalText() {
+	w := g.writer
+	e := g.enum
+	w.Line("func (m *" + e.marshallableStruct + ") UnmarshalText(text []byte) error {")
+
+	// empty text is the text equivalent of JSON null
+	w.Line("\tif len(text) == 0 {")
+	if value := e.nilValue(e.Marshalling.TextOptions.NilToDefault, e.Marshalling.TextOptions.NilToUndefined); value != "" {
+		w.Line("\t\tm.en = " + value)
+	}
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+
+	// OfOrUnknown or OfOrUndefined
+	if lookup := e.unknownNameLookup(e.Marshalling.TextOptions.unknownToUndefined()); lookup != "" {
+		w.Line("\tm.en = " + lookup + "(string(text))")
+	} else {
+		w.Line("\tvalue, err := Of(string(text))")
+		w.Line("\tif err != nil {")
+		w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from text\"), err)")
+		w.Line("\t}")
+		w.Line("\tm.en = value")
+	}
+	w.Line("\treturn nil")
+
+	w.Line("}")
+	w.LineBreak()
+}
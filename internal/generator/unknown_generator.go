@@ -0,0 +1,100 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// unknownGenerator generates the unknown type preserving unrecognised names and IsKnown method
+// for the enums preserving unknown values.
+type unknownGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newUnknownGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *unknownGenerator {
+	return &unknownGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *unknownGenerator) generateIsKnownDeclaration() {
+	if !g.enum.PreserveUnknown {
+		return
+	}
+	g.writer.Line("\tIsKnown() bool")
+}
+
+func (g *unknownGenerator) generateIsKnownMethod() {
+	if !g.enum.PreserveUnknown {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// IsKnown reports whether the value is one of the declared values, false for preserved unknown names.")
+	w.Line("func (b " + e.baseStruct + ") IsKnown() bool {")
+	w.Line("\treturn true")
+	w.Line("}")
+	w.LineBreak()
+}
+
+// generateUnknownType generates the unknown type, embedding the base struct to implement the interface.
+// Unknown values have -1 ordinal and zero code, attributes and description.
+func (g *unknownGenerator) generateUnknownType() {
+	if !g.enum.PreserveUnknown {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("// " + e.unknownStruct + " is an unrecognised " + e.Type + " name preserved by OfOrUnknown and unmarshalling.")
+	w.Line("// It is marshalled back to the original name, has -1 ordinal and is never returned by Values.")
+	w.Line("type " + e.unknownStruct + " struct {")
+	w.Line("\t" + e.baseStruct)
+	w.Line("}")
+	w.LineBreak()
+	w.Line("func (u " + e.unknownStruct + ") IsKnown() bool {")
+	w.Line("\treturn false")
+	w.Line("}")
+	w.LineBreak()
+	g.generateToMarshallable("ToMarshallable", e.Marshalling.enabled())
+	g.generateToMarshallable("ToJSONMarshallable", e.Marshalling.JSONOptions.Generate)
+}
+
+// generateToMarshallable overrides the base struct method, which would wrap the embedded base struct
+// and lose the unknown value.
+func (g *unknownGenerator) generateToMarshallable(method string, enabled bool) {
+	if !enabled {
+		return
+	}
+
+	w := g.writer
+	e := g.enum
+	w.Line("func (u " + e.unknownStruct + ") " + method + "() " + e.marshallableStruct + " {")
+	w.Line("\treturn " + e.marshallableStruct + "{en: u}")
+	w.Line("}")
+	w.LineBreak()
+}
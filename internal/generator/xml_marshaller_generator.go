@@ -0,0 +1,138 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+// xmlMarshallerGenerator generates xml.Marshaler, xml.Unmarshaler, xml.MarshalerAttr and xml.UnmarshalerAttr methods,
+// so the enum can be used both as an element and as an attribute.
+type xmlMarshallerGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newXMLMarshallerGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *xmlMarshallerGenerator {
+	return &xmlMarshallerGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *xmlMarshallerGenerator) imports() []string {
+	if !g.enum.Marshalling.XMLOptions.Generate {
+		return nil
+	}
+	return []string{"encoding/xml", "errors"}
+}
+
+func (g *xmlMarshallerGenerator) generateXMLMarshalling() {
+	if !g.enum.Marshalling.XMLOptions.Generate {
+		return
+	}
+	g.generateMarshalXML()
+	g.generateUnmarshalXML()
+	g.generateMarshalXMLAttr()
+	g.generateUnmarshalXMLAttr()
+	g.generateUnmarshalXMLName()
+}
+
+func (g *xmlMarshallerGenerator) generateMarshalXML() {
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalXML implements xml.Marshaler, nil enum element is omitted.")
+	w.Line("func (m " + e.marshallableStruct + ") MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.Line("\treturn encoder.EncodeElement(m.en.String(), start)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *xmlMarshallerGenerator) generateUnmarshalXML() {
+	w := g.writer
+	e := g.enum
+	w.Line("// UnmarshalXML implements xml.Unmarshaler, empty element is the XML equivalent of JSON null.")
+	w.Line("func (m *" + e.marshallableStruct + ") UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {")
+	w.Line("\tvar name string")
+	w.Line("\tif err := decoder.DecodeElement(&name, &start); err != nil {")
+	w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from XML\"), err)")
+	w.Line("\t}")
+	w.Line("\treturn m.unmarshalXMLName(name)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *xmlMarshallerGenerator) generateMarshalXMLAttr() {
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalXMLAttr implements xml.MarshalerAttr, nil enum attribute is omitted.")
+	w.Line("func (m " + e.marshallableStruct + ") MarshalXMLAttr(name xml.Name) (xml.Attr, error) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn xml.Attr{}, nil")
+	w.Line("\t}")
+	w.Line("\treturn xml.Attr{Name: name, Value: m.en.String()}, nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *xmlMarshallerGenerator) generateUnmarshalXMLAttr() {
+	w := g.writer
+	e := g.enum
+	w.Line("// UnmarshalXMLAttr implements xml.UnmarshalerAttr, empty attribute is the XML equivalent of JSON null.")
+	w.Line("func (m *" + e.marshallableStruct + ") UnmarshalXMLAttr(attr xml.Attr) error {")
+	w.Line("\treturn m.unmarshalXMLName(attr.Value)")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *xmlMarshallerGenerator) generateUnmarshalXMLName() {
+	w := g.writer
+	e := g.enum
+	w.Line("func (m *" + e.marshallableStruct + ") unmarshalXMLName(name string) error {")
+
+	// empty element or attribute
+	w.Line("\tif name == \"\" {")
+	if value := e.nilValue(e.Marshalling.XMLOptions.NilToDefault, e.Marshalling.XMLOptions.NilToUndefined); value != "" {
+		w.Line("\t\tm.en = " + value)
+	}
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+
+	// OfOrUnknown or OfOrUndefined
+	if lookup := e.unknownNameLookup(e.Marshalling.XMLOptions.unknownToUndefined()); lookup != "" {
+		w.Line("\tm.en = " + lookup + "(name)")
+	} else { // or fail
+		w.Line("\tvalue, err := Of(name)")
+		w.Line("\tif err != nil {")
+		w.Line("\t\treturn errors.Join(errors.New(\"could not unmarshal " + e.Type + " from XML\"), err)")
+		w.Line("\t}")
+		w.Line("\tm.en = value")
+	}
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package generator
+
+const yamlImportPath = "gopkg.in/yaml.v3"
+
+// yamlMarshallerGenerator generates gopkg.in/yaml.v3 yaml.Marshaler and yaml.Unmarshaler methods.
+type yamlMarshallerGenerator struct {
+	enum   generationEnum
+	writer *Writer
+}
+
+func newYAMLMarshallerGenerator(
+	enum generationEnum,
+	writer *Writer,
+) *yamlMarshallerGenerator {
+	return &yamlMarshallerGenerator{
+		enum:   enum,
+		writer: writer,
+	}
+}
+
+func (g *yamlMarshallerGenerator) imports() []string {
+	if !g.enum.Marshalling.YAMLOptions.Generate {
+		return nil
+	}
+	if g.enum.unknownNameLookup(g.enum.Marshalling.YAMLOptions.unknownToUndefined()) != "" {
+		return []string{"strconv", yamlImportPath}
+	}
+	return []string{"fmt", "strconv", yamlImportPath}
+}
+
+func (g *yamlMarshallerGenerator) generateYAMLMarshalling() {
+	if !g.enum.Marshalling.YAMLOptions.Generate {
+		return
+	}
+	g.generateMarshalYAML()
+	g.generateUnmarshalYAML()
+}
+
+func (g *yamlMarshallerGenerator) generateMarshalYAML() {
+	w := g.writer
+	e := g.enum
+	w.Line("// MarshalYAML implements yaml.Marshaler, nil enum is marshalled to null.")
+	w.Line("func (m " + e.marshallableStruct + ") MarshalYAML() (any, error) {")
+	w.Line("\tif m.en == nil {")
+	w.Line("\t\treturn nil, nil")
+	w.Line("\t}")
+	w.Line("\treturn m.en.String(), nil")
+	w.Line("}")
+	w.LineBreak()
+}
+
+func (g *yamlMarshallerGenerator) generateUnmarshalYAML() {
+	w := g.writer
+	e := g.enum
+	w.Line("// UnmarshalYAML implements yaml.Unmarshaler accepting YAML string and null scalars only.")
+	w.Line("// Any other node is rejected with *yaml.TypeError, errors carry the node line number.")
+	w.Line("func (m *" + e.marshallableStruct + ") UnmarshalYAML(node *yaml.Node) error {")
+
+	// null node
+	w.Line("\tif node.Kind == yaml.ScalarNode && node.ShortTag() == \"!!null\" {")
+	if value := e.nilValue(e.Marshalling.YAMLOptions.NilToDefault, e.Marshalling.YAMLOptions.NilToUndefined); value != "" {
+		w.Line("\t\tm.en = " + value)
+	}
+	w.Line("\t\treturn nil")
+	w.Line("\t}")
+	w.LineBreak()
+
+	// non-string node
+	w.Line("\tif node.Kind != yaml.ScalarNode || node.ShortTag() != \"!!str\" {")
+	w.Line("\t\treturn &yaml.TypeError{Errors: []string{")
+	w.Line("\t\t\t\"line \" + strconv.Itoa(node.Line) + \": cannot unmarshal \" + node.ShortTag() +")
+	w.Line("\t\t\t\t\" `\" + node.Value + \"` into " + e.Type + "\",")
+	w.Line("\t\t}}")
+	w.Line("\t}")
+	w.LineBreak()
+
+	// OfOrUnknown or OfOrUndefined
+	if lookup := e.unknownNameLookup(e.Marshalling.YAMLOptions.unknownToUndefined()); lookup != "" {
+		w.Line("\tm.en = " + lookup + "(node.Value)")
+	} else { // or fail
+		w.Line("\tvalue, err := Of(node.Value)")
+		w.Line("\tif err != nil {")
+		w.Line("\t\treturn fmt.Errorf(\"could not unmarshal " + e.Type + " from YAML at line %d: %w\", node.Line, err)")
+		w.Line("\t}")
+		w.Line("\tm.en = value")
+	}
+	w.Line("\treturn nil")
+	w.Line("}")
+	w.LineBreak()
+}
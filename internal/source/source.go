@@ -0,0 +1,344 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package source
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tompaz3/go-enumerator/internal/generator"
+)
+
+const (
+	enumDirective  = "//enumerator:enum"
+	valueDirective = "//enumerator:value"
+)
+
+var (
+	ErrNoEnums             = errors.New("no //enumerator:enum annotated types found")
+	ErrMissingValues       = errors.New("annotated type must be followed by a const declaration")
+	ErrUnknownOption       = errors.New("unknown //enumerator:enum or //enumerator:value option")
+	ErrInvalidOptionSyntax = errors.New("invalid //enumerator:enum or //enumerator:value option syntax")
+)
+
+// Load parses the Go source file and returns an enum for every type annotated with
+// the //enumerator:enum directive. Enum values are taken from the const declaration
+// following the annotated type, each optionally annotated with the //enumerator:value directive.
+// Type and value doc comments (directives excluded) become the enum and value descriptions,
+// the "Deprecated: " paragraph of the value doc comment marks the value as deprecated.
+// The generated file is placed next to the source file, unless the destination option says otherwise.
+func Load(path string) ([]generator.Enum, error) {
+	fileSet := token.NewFileSet()
+	file, parseErr := parser.ParseFile(fileSet, path, nil, parser.ParseComments)
+	if parseErr != nil {
+		return nil, newParseError(path, parseErr)
+	}
+
+	baseDir := filepath.Dir(path)
+	enums := make([]generator.Enum, 0)
+	for i, decl := range file.Decls {
+		typeSpec, doc, options, annotated := annotatedType(decl)
+		if !annotated {
+			continue
+		}
+
+		enum, enumErr := newEnum(file.Name.Name, typeSpec.Name.Name, options, baseDir)
+		if enumErr != nil {
+			return nil, newParseError(path, fmt.Errorf("type %s: %w", typeSpec.Name.Name, enumErr))
+		}
+		enum.Description = description(doc)
+
+		values, valuesErr := constValues(file.Decls, i+1)
+		if valuesErr != nil {
+			return nil, newParseError(path, fmt.Errorf("type %s: %w", enum.Type, valuesErr))
+		}
+		enum.Values = values
+
+		enums = append(enums, enum)
+	}
+
+	if len(enums) == 0 {
+		return nil, newParseError(path, ErrNoEnums)
+	}
+	return enums, nil
+}
+
+// annotatedType returns the type spec, its doc comment holding the directive and the directive options.
+func annotatedType(decl ast.Decl) (*ast.TypeSpec, *ast.CommentGroup, []string, bool) {
+	genDecl, ok := decl.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.TYPE || len(genDecl.Specs) != 1 {
+		return nil, nil, nil, false
+	}
+	typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+	if !ok {
+		return nil, nil, nil, false
+	}
+
+	for _, doc := range []*ast.CommentGroup{genDecl.Doc, typeSpec.Doc} {
+		if options, found := directiveOptions(enumDirective, doc); found {
+			return typeSpec, doc, options, true
+		}
+	}
+	return nil, nil, nil, false
+}
+
+// description returns the doc comment text, directives excluded.
+func description(doc *ast.CommentGroup) string {
+	return strings.TrimSpace(doc.Text())
+}
+
+// valueDoc returns the value description and the deprecation message
+// taken from the "Deprecated: " paragraph of the doc comment.
+func valueDoc(doc *ast.CommentGroup) (string, string) {
+	paragraphs := strings.Split(description(doc), "\n\n")
+	descriptionParagraphs := make([]string, 0, len(paragraphs))
+	deprecated := ""
+	for _, paragraph := range paragraphs {
+		if message, found := strings.CutPrefix(paragraph, "Deprecated: "); found {
+			deprecated = strings.TrimSpace(message)
+			continue
+		}
+		descriptionParagraphs = append(descriptionParagraphs, paragraph)
+	}
+	return strings.Join(descriptionParagraphs, "\n\n"), deprecated
+}
+
+func directiveOptions(directive string, doc *ast.CommentGroup) ([]string, bool) {
+	if doc == nil {
+		return nil, false
+	}
+	for _, comment := range doc.List {
+		if comment.Text == directive {
+			return []string{}, true
+		}
+		if options, found := strings.CutPrefix(comment.Text, directive+" "); found {
+			return strings.Fields(options), true
+		}
+	}
+	return nil, false
+}
+
+func newEnum(packageName, typeName string, options []string, baseDir string) (generator.Enum, error) {
+	destination := filepath.Join(baseDir, strings.ToLower(typeName)+".go")
+	enum := generator.Enum{
+		Destination: &destination,
+		Package:     packageName,
+		Type:        typeName,
+	}
+
+	for _, option := range options {
+		key, value, hasValue := strings.Cut(option, "=")
+		if hasValue != optionRequiresValue(key) {
+			return generator.Enum{}, fmt.Errorf("%w: %q", ErrInvalidOptionSyntax, option)
+		}
+
+		switch key {
+		case "json":
+			enum.Marshalling.JSONOptions.Generate = true
+		case "nil-to-undefined":
+			enum.Marshalling.JSONOptions.NilToUndefined = true
+		case "nil-to-default":
+			enum.Marshalling.JSONOptions.NilToDefault = true
+		case "unknown-to-undefined":
+			enum.Marshalling.JSONOptions.UnknownToUndefined = true
+		case "json-v2":
+			enum.Marshalling.JSONOptions.V2 = true
+		case "json-as-code":
+			enum.Marshalling.JSONOptions.AsCode = true
+		case "naming":
+			enum.Naming = generator.NamingStrategy(value)
+		case "ignore-case":
+			enum.Parsing.IgnoreCase = true
+		case "trim-space":
+			enum.Parsing.TrimSpace = true
+		case "ignore-separators":
+			enum.Parsing.IgnoreSeparators = true
+		case "text":
+			enum.Marshalling.TextOptions.Generate = true
+		case "text-nil-to-undefined":
+			enum.Marshalling.TextOptions.NilToUndefined = true
+		case "text-nil-to-default":
+			enum.Marshalling.TextOptions.NilToDefault = true
+		case "text-unknown-to-undefined":
+			enum.Marshalling.TextOptions.UnknownToUndefined = true
+		case "yaml":
+			enum.Marshalling.YAMLOptions.Generate = true
+		case "yaml-nil-to-undefined":
+			enum.Marshalling.YAMLOptions.NilToUndefined = true
+		case "yaml-nil-to-default":
+			enum.Marshalling.YAMLOptions.NilToDefault = true
+		case "yaml-unknown-to-undefined":
+			enum.Marshalling.YAMLOptions.UnknownToUndefined = true
+		case "xml":
+			enum.Marshalling.XMLOptions.Generate = true
+		case "xml-nil-to-undefined":
+			enum.Marshalling.XMLOptions.NilToUndefined = true
+		case "xml-nil-to-default":
+			enum.Marshalling.XMLOptions.NilToDefault = true
+		case "xml-unknown-to-undefined":
+			enum.Marshalling.XMLOptions.UnknownToUndefined = true
+		case "binary":
+			enum.Marshalling.BinaryOptions.Generate = true
+		case "binary-numeric":
+			enum.Marshalling.BinaryOptions.Numeric = true
+		case "graphql":
+			enum.Marshalling.GraphQLOptions.Generate = true
+		case "sql":
+			enum.Marshalling.SQLOptions.Generate = true
+		case "sql-null-to-undefined":
+			enum.Marshalling.SQLOptions.NullToUndefined = true
+		case "sql-null-to-default":
+			enum.Marshalling.SQLOptions.NullToDefault = true
+		case "sql-unknown-to-undefined":
+			enum.Marshalling.SQLOptions.UnknownToUndefined = true
+		case "sql-as-code":
+			enum.Marshalling.SQLOptions.AsCode = true
+		case "preserve-unknown":
+			enum.PreserveUnknown = true
+		case "nullable":
+			enum.Nullable = true
+		case "set":
+			enum.Set = true
+		case "flag":
+			enum.Flag = true
+		case "sumtype":
+			enum.CheckSumType = true
+		case "undefined":
+			enum.UndefinedValue = value
+		case "default":
+			enum.DefaultValue = value
+		case "destination":
+			resolved := resolvePath(baseDir, value)
+			enum.Destination = &resolved
+		case "copyright":
+			enum.CopyrightFile = resolvePath(baseDir, value)
+		default:
+			return generator.Enum{}, fmt.Errorf("%w: %q", ErrUnknownOption, option)
+		}
+	}
+
+	return enum, nil
+}
+
+func optionRequiresValue(key string) bool {
+	switch key {
+	case "undefined", "default", "naming", "destination", "copyright":
+		return true
+	default:
+		return false
+	}
+}
+
+func constValues(decls []ast.Decl, index int) ([]generator.Value, error) {
+	if index >= len(decls) {
+		return nil, ErrMissingValues
+	}
+	genDecl, ok := decls[index].(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.CONST {
+		return nil, ErrMissingValues
+	}
+
+	values := make([]generator.Value, 0, len(genDecl.Specs))
+	for _, spec := range genDecl.Specs {
+		valueSpec, isValueSpec := spec.(*ast.ValueSpec)
+		if !isValueSpec {
+			continue
+		}
+		for i, name := range valueSpec.Names {
+			valueName, err := constValueName(valueSpec.Values, i)
+			if err != nil {
+				return nil, err
+			}
+			valueDescription, deprecated := valueDoc(valueSpec.Doc)
+			value := generator.Value{
+				Identifier:  name.Name,
+				Name:        valueName,
+				Description: valueDescription,
+				Deprecated:  deprecated,
+			}
+			if err := applyValueOptions(&value, valueSpec); err != nil {
+				return nil, fmt.Errorf("value %s: %w", name.Name, err)
+			}
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+// applyValueOptions applies the //enumerator:value directive options,
+// declared either in the const doc comment or in the line comment.
+func applyValueOptions(value *generator.Value, valueSpec *ast.ValueSpec) error {
+	for _, doc := range []*ast.CommentGroup{valueSpec.Doc, valueSpec.Comment} {
+		options, found := directiveOptions(valueDirective, doc)
+		if !found {
+			continue
+		}
+		for _, option := range options {
+			key, optionValue, hasValue := strings.Cut(option, "=")
+			if !hasValue {
+				return fmt.Errorf("%w: %q", ErrInvalidOptionSyntax, option)
+			}
+			switch key {
+			case "alias":
+				value.Aliases = append(value.Aliases, optionValue)
+			case "code":
+				code, err := strconv.Atoi(optionValue)
+				if err != nil {
+					return fmt.Errorf("%w: %q", ErrInvalidOptionSyntax, option)
+				}
+				value.Code = &code
+			default:
+				return fmt.Errorf("%w: %q", ErrUnknownOption, option)
+			}
+		}
+	}
+	return nil
+}
+
+// constValueName returns the string const value as the value name.
+// Consts without a value (plain identifier list) and non-string values (e.g. iota)
+// have no explicit name, so the name is derived from the identifier.
+func constValueName(values []ast.Expr, index int) (string, error) {
+	if index >= len(values) {
+		return "", nil
+	}
+	literal, ok := values[index].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", nil
+	}
+	return strconv.Unquote(literal.Value)
+}
+
+func resolvePath(baseDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
@@ -0,0 +1,223 @@
+// MIT License
+//
+// Copyright (c) 2024-2026 Tomasz Paździurek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+package source_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/go-enumerator/internal/generator"
+	"github.com/tompaz3/go-enumerator/internal/source"
+)
+
+func Test_Load(t *testing.T) {
+	t.Parallel()
+
+	// given
+	colorDestination := "testdata/color.go"
+	shapeDestination := "testdata/shape/shape.go"
+	statusDestination := "testdata/status/status.go"
+	circleCode, roundedSquareCode := 1, 2
+	expected := []generator.Enum{
+		{
+			Destination:   &colorDestination,
+			CopyrightFile: "LICENSE",
+			Package:       "color",
+			Type:          "Color",
+			Values: []generator.Value{
+				{Identifier: "Unknown", Name: "Unknown"},
+				{
+					Identifier:  "Red",
+					Name:        "Red",
+					Aliases:     []string{"Crimson"},
+					Description: "Red was previously called Crimson.",
+				},
+				{Identifier: "Green", Name: "Green", Aliases: []string{"Lime", "Emerald"}},
+				{
+					Identifier:  "LightBlue",
+					Name:        "Light Blue",
+					Description: "LightBlue is a pale blue.",
+					Deprecated:  "Light colors are no longer supported.",
+				},
+			},
+			UndefinedValue: "Unknown",
+			DefaultValue:   "Green",
+			Parsing: generator.ParseOptions{
+				IgnoreCase: true,
+				TrimSpace:  true,
+			},
+			Marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{
+					Generate:       true,
+					NilToUndefined: true,
+					V2:             true,
+				},
+				TextOptions: generator.TextMarshalOptions{
+					Generate:       true,
+					NilToUndefined: true,
+				},
+				YAMLOptions: generator.YAMLMarshalOptions{
+					Generate:       true,
+					NilToUndefined: true,
+				},
+				XMLOptions: generator.XMLMarshalOptions{
+					Generate:           true,
+					NilToDefault:       true,
+					UnknownToUndefined: true,
+				},
+				BinaryOptions: generator.BinaryMarshalOptions{
+					Generate: true,
+					Numeric:  true,
+				},
+				GraphQLOptions: generator.GraphQLMarshalOptions{
+					Generate: true,
+				},
+				SQLOptions: generator.SQLMarshalOptions{
+					Generate:           true,
+					UnknownToUndefined: true,
+				},
+			},
+			Nullable:     true,
+			Flag:         true,
+			CheckSumType: true,
+		},
+		{
+			Destination: &shapeDestination,
+			Package:     "color",
+			Type:        "Shape",
+			Description: "Shape is a plain enum with values declared as identifier list.",
+			Values: []generator.Value{
+				{Identifier: "Circle", Code: &circleCode},
+				{Identifier: "RoundedSquare", Code: &roundedSquareCode},
+			},
+			Naming: generator.NamingKebabCase,
+			Marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{
+					Generate: true,
+					AsCode:   true,
+				},
+				SQLOptions: generator.SQLMarshalOptions{
+					Generate: true,
+					AsCode:   true,
+				},
+			},
+		},
+		{
+			Destination: &statusDestination,
+			Package:     "color",
+			Type:        "Status",
+			Description: "Status keeps the names unknown to this version.",
+			Values: []generator.Value{
+				{Identifier: "Active", Name: "Active"},
+				{Identifier: "Inactive", Name: "Inactive"},
+			},
+			Marshalling: generator.MarshalOptions{
+				JSONOptions: generator.JSONMarshalOptions{
+					Generate: true,
+				},
+				TextOptions: generator.TextMarshalOptions{
+					Generate: true,
+				},
+			},
+			PreserveUnknown: true,
+			Set:             true,
+		},
+	}
+
+	// when
+	enums, err := source.Load("testdata/color_def.go")
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, enums)
+}
+
+func Test_Load_Error(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		path     string
+		expected error
+	}{
+		{
+			name:     `GIVEN no annotated types WHEN Load THEN error`,
+			path:     "testdata/no_enums.go",
+			expected: source.ErrNoEnums,
+		},
+		{
+			name:     `GIVEN unknown option WHEN Load THEN error`,
+			path:     "testdata/unknown_option.go",
+			expected: source.ErrUnknownOption,
+		},
+		{
+			name:     `GIVEN unknown value option WHEN Load THEN error`,
+			path:     "testdata/unknown_value_option.go",
+			expected: source.ErrUnknownOption,
+		},
+		{
+			name:     `GIVEN non-integer value code WHEN Load THEN error`,
+			path:     "testdata/invalid_code.go",
+			expected: source.ErrInvalidOptionSyntax,
+		},
+		{
+			name:     `GIVEN annotated type without const declaration WHEN Load THEN error`,
+			path:     "testdata/missing_values.go",
+			expected: source.ErrMissingValues,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			enums, err := source.Load(tt.path)
+
+			// then
+			assert.Nil(t, enums)
+			var parseError source.ParseError
+			assert.ErrorAs(t, err, &parseError)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_Load_SharedPackageDirectory(t *testing.T) {
+	t.Parallel()
+
+	// given
+	enums, loadErr := source.Load("testdata/shared_package.go")
+
+	// when
+	err := generator.GenerateAll(enums)
+
+	// then
+	assert.NoError(t, loadErr)
+	assert.Len(t, enums, 2)
+	assert.ErrorIs(t, err, generator.ErrSharedPackageDirectory)
+	// and
+	assert.NoFileExists(t, "testdata/color.go")
+	assert.NoFileExists(t, "testdata/shape.go")
+}
@@ -0,0 +1,42 @@
+//go:build ignore
+
+package color
+
+//enumerator:enum json nil-to-undefined json-v2 text text-nil-to-undefined yaml yaml-nil-to-undefined xml xml-nil-to-default xml-unknown-to-undefined binary binary-numeric graphql sql sql-unknown-to-undefined nullable flag undefined=Unknown default=Green ignore-case trim-space sumtype copyright=../LICENSE
+type Color struct{}
+
+const (
+	Unknown = "Unknown"
+	// Red was previously called Crimson.
+	//
+	//enumerator:value alias=Crimson
+	Red   = "Red"
+	Green = "Green" //enumerator:value alias=Lime alias=Emerald
+	// LightBlue is a pale blue.
+	//
+	// Deprecated: Light colors are no longer supported.
+	LightBlue = "Light Blue"
+)
+
+// Shape is a plain enum with values declared as identifier list.
+//
+//enumerator:enum naming=kebab-case json json-as-code sql sql-as-code destination=./shape/shape.go
+type Shape struct{}
+
+const (
+	Circle        = iota //enumerator:value code=1
+	RoundedSquare        //enumerator:value code=2
+)
+
+// Status keeps the names unknown to this version.
+//
+//enumerator:enum json text preserve-unknown set destination=./status/status.go
+type Status struct{}
+
+const (
+	Active   = "Active"
+	Inactive = "Inactive"
+)
+
+// NotAnEnum is not annotated and is skipped.
+type NotAnEnum struct{}
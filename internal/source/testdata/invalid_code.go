@@ -0,0 +1,10 @@
+//go:build ignore
+
+package color
+
+//enumerator:enum
+type Color struct{}
+
+const (
+	Red = "Red" //enumerator:value code=ten
+)
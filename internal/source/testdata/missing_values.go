@@ -0,0 +1,8 @@
+//go:build ignore
+
+package color
+
+//enumerator:enum
+type Color struct{}
+
+var Red = "Red"
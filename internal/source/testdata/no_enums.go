@@ -0,0 +1,5 @@
+//go:build ignore
+
+package color
+
+type Color struct{}
@@ -0,0 +1,19 @@
+//go:build ignore
+
+package color
+
+//enumerator:enum
+type Color struct{}
+
+const (
+	Red   = "Red"
+	Green = "Green"
+)
+
+//enumerator:enum
+type Shape struct{}
+
+const (
+	Circle = "Circle"
+	Square = "Square"
+)
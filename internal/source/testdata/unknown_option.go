@@ -0,0 +1,10 @@
+//go:build ignore
+
+package color
+
+//enumerator:enum protobuf
+type Color struct{}
+
+const (
+	Red = "Red"
+)
@@ -29,30 +29,22 @@ import (
 	"os"
 	"strings"
 
+	"github.com/tompaz3/go-enumerator/internal/config"
 	"github.com/tompaz3/go-enumerator/internal/generator"
+	"github.com/tompaz3/go-enumerator/internal/source"
 )
 
 var version = "v0.0.10"
 
 func main() {
 	inputArgs := strings.Join(os.Args, " ")
-	copyrightFile := flag.String("copyright", "", "license file")
-	destination := flag.String("destination", "", "destination file")
-	packageName := flag.String("package", "", "package name")
-	typeName := flag.String("type", "", "type name")
-	valueNames := flag.String("values", "", "comma-separated values")
-	undefinedValue := flag.String("undefined", "", "undefined value name - must be one of the values")
-	marshalJSON := flag.Bool("marshal-json", false, "generate JSON marshalling")
-	unmarshalUnknownToUndefined := flag.Bool(
-		"unmarshal-json-to-undefined",
-		false,
-		"unmarshal unknown or null values to undefined",
-	)
-	checkSumType := flag.Bool(
-		"go-check-sumtype",
-		false,
-		"add go-check-sumtype comment for exhaustiveness check using https://github.com/alecthomas/go-check-sumtype",
+	configFile := flag.String("config", "", "enums config file (YAML or JSON) - all the other enum flags are ignored")
+	sourceFile := flag.String(
+		"source",
+		"",
+		"Go source file with //enumerator:enum annotated types - all the other enum flags are ignored",
 	)
+	enumFlags := newEnumFlags()
 	versionPrintRequested := flag.Bool("version", false, "print version")
 	flag.Parse()
 
@@ -61,25 +53,23 @@ func main() {
 		return
 	}
 
-	values := stripValueNames(*valueNames)
-
-	enum := generator.Enum{
-		InputArgs:      inputArgs,
-		CopyrightFile:  *copyrightFile,
-		Destination:    destination,
-		Package:        *packageName,
-		Type:           *typeName,
-		Values:         values,
-		UndefinedValue: *undefinedValue,
-		Marshalling: generator.MarshalOptions{
-			JSONOptions: generator.JSONMarshalOptions{
-				Generate:       *marshalJSON,
-				NilToUndefined: *unmarshalUnknownToUndefined,
-			},
-		},
-		CheckSumType: *checkSumType,
+	if *configFile != "" {
+		generateFrom(config.Load, *configFile, inputArgs)
+		return
 	}
-	err := generator.Generate(enum)
+
+	if *sourceFile != "" {
+		generateFrom(source.Load, *sourceFile, inputArgs)
+		return
+	}
+
+	enum, err := enumFlags.enum(inputArgs)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	err = generator.Generate(enum)
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -88,11 +78,33 @@ func main() {
 	fmt.Printf("Generated %s to %s!\n", enum.Type, *enum.Destination)
 }
 
-func stripValueNames(valueNames string) []string {
-	if valueNames == "" {
-		return []string{}
+func generateFrom(load func(path string) ([]generator.Enum, error), path, inputArgs string) {
+	enums, err := load(path)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for i := range enums {
+		enums[i].InputArgs = inputArgs
+	}
+
+	err = generator.GenerateAll(enums)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, enum := range enums {
+		fmt.Printf("Generated %s to %s!\n", enum.Type, destinationName(enum.Destination))
+	}
+}
+
+func destinationName(destination *string) string {
+	if destination == nil || *destination == "" {
+		return "stdout"
 	}
-	return strings.Split(valueNames, ",")
+	return *destination
 }
 
 func printVersion() {